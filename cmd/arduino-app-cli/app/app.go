@@ -39,6 +39,12 @@ func NewAppCmd(cfg config.Configuration) *cobra.Command {
 	appCmd.AddCommand(newLogsCmd(cfg))
 	appCmd.AddCommand(newListCmd(cfg))
 	appCmd.AddCommand(newMonitorCmd(cfg))
+	appCmd.AddCommand(newExportCmd(cfg))
+	appCmd.AddCommand(newImportCmd(cfg))
+	appCmd.AddCommand(newValidateCmd(cfg))
+	appCmd.AddCommand(newSecretCmd(cfg))
+	appCmd.AddCommand(newHistoryCmd(cfg))
+	appCmd.AddCommand(newDeployCmd(cfg))
 
 	return appCmd
 }
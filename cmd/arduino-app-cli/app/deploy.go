@@ -0,0 +1,97 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newDeployCmd(cfg config.Configuration) *cobra.Command {
+	var flash, force bool
+	cmd := &cobra.Command{
+		Use:   "deploy app_path",
+		Short: "Deploy the sketch of an Arduino App to the micro",
+		Long: "Compile and upload the sketch of the app to the micro, without starting the Python part of the app.\n" +
+			"With --flash the sketch is burnt in the flash of the micro and runs again after a power cycle, " +
+			"otherwise the deploy mode set in the sketch.deploy field of the app.yaml is used.\n" +
+			"The deploy fails if it would overwrite the sketch of another app stored in the flash of the micro, unless --force is used.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0])
+			if err != nil {
+				return err
+			}
+			return deployHandler(cmd.Context(), cfg, app, flash, force)
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+	cmd.Flags().BoolVar(&flash, "flash", false, "Burn the sketch in the flash of the micro")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite the sketch of another app stored in the flash of the micro")
+	return cmd
+}
+
+func deployHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp, flash, force bool) error {
+	out, _, getResult := feedback.OutputStreams()
+
+	for message := range orchestrator.DeploySketch(ctx, app, cfg, flash, force) {
+		switch message.GetType() {
+		case orchestrator.ProgressType:
+			fmt.Fprintf(out, "Progress[%s]: %.0f%%\n", message.GetProgress().Name, message.GetProgress().Progress)
+		case orchestrator.InfoType:
+			fmt.Fprintln(out, "[INFO]", message.GetData())
+		case orchestrator.ErrorType:
+			errMesg := cases.Title(language.AmericanEnglish).String(message.GetError().Error())
+			feedback.Fatal(fmt.Sprintf("[ERROR] %s%s", errMesg, flashOwnedHint(message.GetError())), feedback.ErrGeneric)
+			return nil
+		}
+	}
+
+	mode := app.Descriptor.GetSketchDeployMode()
+	if flash {
+		mode = "flash"
+	}
+	feedback.PrintResult(deployAppResult{
+		AppName: app.Name,
+		Mode:    string(mode),
+		Output:  getResult(),
+	})
+	return nil
+}
+
+type deployAppResult struct {
+	AppName string                        `json:"appName"`
+	Mode    string                        `json:"mode"`
+	Output  *feedback.OutputStreamsResult `json:"output,omitempty"`
+}
+
+func (r deployAppResult) String() string {
+	return fmt.Sprintf("✓ Sketch of app %q deployed in %s", r.AppName, r.Mode)
+}
+
+func (r deployAppResult) Data() interface{} {
+	return r
+}
@@ -0,0 +1,100 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/gosimple/slug"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newExportCmd(cfg config.Configuration) *cobra.Command {
+	var (
+		output      string
+		includeData bool
+	)
+	cmd := &cobra.Command{
+		Use:   "export app_path",
+		Short: "Export the app as an archive",
+		Long:  "Export the app as a versioned archive that can be imported on another board with the import command.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0])
+			if err != nil {
+				return err
+			}
+			return exportHandler(cmd.Context(), cfg, app, output, includeData)
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Path of the archive to create (default: <app-name>.tar.gz in the current directory)")
+	cmd.Flags().BoolVar(&includeData, "include-data", false, "Include the data folder of the app")
+	return cmd
+}
+
+func exportHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp, output string, includeData bool) error {
+	dst := paths.New(output)
+	if dst == nil {
+		dst = paths.New(slug.Make(app.Name) + ".tar.gz")
+	}
+	if dst.Exist() {
+		feedback.Fatal(fmt.Sprintf("file %s already exists", dst), feedback.ErrBadArgument)
+		return nil
+	}
+
+	bricksIndex := servicelocator.GetBricksIndex()
+	f, err := dst.Create()
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+		return nil
+	}
+	err = orchestrator.ExportApp(ctx, f, app, orchestrator.ExportAppRequest{IncludeData: includeData}, bricksIndex, cfg)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = dst.Remove()
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+		return nil
+	}
+
+	feedback.PrintResult(exportAppResult{
+		Path: dst.String(),
+	})
+	return nil
+}
+
+type exportAppResult struct {
+	Path string `json:"path"`
+}
+
+func (r exportAppResult) String() string {
+	return fmt.Sprintf("App exported to %s", r.Path)
+}
+
+func (r exportAppResult) Data() interface{} {
+	return r
+}
@@ -0,0 +1,128 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
+)
+
+func newHistoryCmd(cfg config.Configuration) *cobra.Command {
+	var runID int
+	cmd := &cobra.Command{
+		Use:   "history app_path",
+		Short: "Show the start and stop history of the app",
+		Long:  "Show the last starts and stops of the app. Use --run to show the phases, the output and the error of a single run.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			res, err := orchestrator.GetAppRuns(app)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return nil
+			}
+			if runID == 0 {
+				feedback.PrintResult(appHistoryResult(res))
+				return nil
+			}
+			idx := slices.IndexFunc(res.Runs, func(r orchestrator.AppRun) bool { return r.ID == runID })
+			if idx == -1 {
+				feedback.Fatal(fmt.Sprintf("run %d not found", runID), feedback.ErrBadArgument)
+				return nil
+			}
+			feedback.PrintResult(appRunResult(res.Runs[idx]))
+			return nil
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+	cmd.Flags().IntVar(&runID, "run", 0, "Show the details of the run with the given ID")
+	return cmd
+}
+
+type appHistoryResult orchestrator.AppRunsResult
+
+func (r appHistoryResult) String() string {
+	if len(r.Runs) == 0 {
+		return "No runs recorded"
+	}
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"ID", "ACTION", "STARTED", "DURATION", "STATUS", "ERROR"})
+	for _, run := range r.Runs {
+		duration := ""
+		if run.FinishedAt != nil {
+			duration = run.FinishedAt.Sub(run.StartedAt).Round(time.Millisecond).String()
+		}
+		t.AppendRow(table.Row{
+			run.ID,
+			run.Action,
+			run.StartedAt.Local().Format(time.DateTime),
+			duration,
+			run.Status,
+			run.Error,
+		})
+	}
+	return t.Render()
+}
+
+func (r appHistoryResult) Data() interface{} {
+	return r
+}
+
+type appRunResult orchestrator.AppRun
+
+func (r appRunResult) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Run %d: %s %s at %s\n", r.ID, r.Action, r.Status, r.StartedAt.Local().Format(time.DateTime))
+	if len(r.Phases) > 0 {
+		b.WriteString("\nPHASES\n")
+		for _, phase := range r.Phases {
+			fmt.Fprintf(&b, "%s  %s (%.0f%%)\n", phase.At.Local().Format(time.TimeOnly), phase.Name, phase.Progress)
+		}
+	}
+	if len(r.Output) > 0 {
+		b.WriteString("\nOUTPUT\n")
+		if r.OutputTruncated {
+			b.WriteString("...\n")
+		}
+		for _, line := range r.Output {
+			b.WriteString(line + "\n")
+		}
+	}
+	if r.Error != "" {
+		b.WriteString("\nERROR\n" + r.Error + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r appRunResult) Data() interface{} {
+	return r
+}
@@ -0,0 +1,107 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newImportCmd(cfg config.Configuration) *cobra.Command {
+	var name string
+	cmd := &cobra.Command{
+		Use:   "import archive_path",
+		Short: "Import an app from an archive",
+		Long:  "Import an app from an archive created with the export command. Bricks and models required by the app that are not available on this board are reported.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return importHandler(cmd.Context(), cfg, paths.New(args[0]), name)
+		},
+	}
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the imported app (default: the name stored in the archive)")
+	return cmd
+}
+
+func importHandler(ctx context.Context, cfg config.Configuration, archive *paths.Path, name string) error {
+	f, err := archive.Open()
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		return nil
+	}
+	defer f.Close()
+
+	var req orchestrator.ImportAppRequest
+	if name != "" {
+		req.Name = &name
+	}
+	res, err := orchestrator.ImportApp(
+		ctx,
+		f,
+		req,
+		servicelocator.GetAppIDProvider(),
+		servicelocator.GetBricksIndex(),
+		servicelocator.GetModelsIndex(),
+		cfg,
+	)
+	if err != nil {
+		if errors.Is(err, orchestrator.ErrInvalidAppArchive) || errors.Is(err, orchestrator.ErrAppAlreadyExists) {
+			feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		} else {
+			feedback.Fatal(err.Error(), feedback.ErrGeneric)
+		}
+		return nil
+	}
+
+	feedback.PrintResult(importAppResult{
+		ImportAppResponse: res,
+		Path:              res.ID.ToPath().String(),
+	})
+	return nil
+}
+
+type importAppResult struct {
+	orchestrator.ImportAppResponse
+	Path string `json:"path"`
+}
+
+func (r importAppResult) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "App imported successfully: %s\n", r.Path)
+	if r.RunnerVersionMismatch {
+		fmt.Fprintf(&b, "Warning: the app was exported with runner version %s\n", r.Manifest.RunnerVersion)
+	}
+	if len(r.MissingBricks) > 0 {
+		fmt.Fprintf(&b, "Missing bricks: %s\n", strings.Join(r.MissingBricks, ", "))
+	}
+	if len(r.MissingModels) > 0 {
+		fmt.Fprintf(&b, "Missing models: %s\n", strings.Join(r.MissingModels, ", "))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r importAppResult) Data() interface{} {
+	return r
+}
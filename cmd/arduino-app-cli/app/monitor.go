@@ -16,19 +16,94 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
 	"github.com/spf13/cobra"
 
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/monitor"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
 func newMonitorCmd(cfg config.Configuration) *cobra.Command {
-	return &cobra.Command{
-		Use:   "monitor",
+	var (
+		lineEnding string
+		encoding   string
+		timestamps bool
+	)
+	cmd := &cobra.Command{
+		Use:   "monitor [app_path]",
 		Short: "Monitor the Arduino app",
+		Long:  "Open the serial monitor of the sketch running on the MCU. The output is streamed to the terminal and the standard input is forwarded to the sketch.",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			panic("not implemented")
+			if len(args) == 1 {
+				app, err := Load(args[0])
+				if err != nil {
+					return err
+				}
+				if app.MainSketchPath == nil {
+					feedback.Fatal(fmt.Sprintf("app %q has no sketch to monitor", app.Name), feedback.ErrBadArgument)
+					return nil
+				}
+			}
+
+			le, err := monitor.ParseLineEnding(lineEnding)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			enc, err := monitor.ParseEncoding(encoding)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			return monitorHandler(cmd.Context(), le, enc, timestamps)
 		},
 		ValidArgsFunction: completion.ApplicationNames(cfg),
 	}
+	cmd.Flags().StringVar(&lineEnding, "line-ending", string(monitor.LineEndingNL), "Line ending appended to the input sent to the sketch (none, nl, cr, crnl)")
+	cmd.Flags().StringVar(&encoding, "encoding", string(monitor.EncodingASCII), "Encoding used to print the sketch output (ascii, hex)")
+	cmd.Flags().BoolVar(&timestamps, "timestamp", false, "Prefix every line of output with a timestamp")
+	return cmd
+}
+
+func monitorHandler(ctx context.Context, lineEnding monitor.LineEnding, encoding monitor.Encoding, timestamps bool) error {
+	stdin, stdout, err := feedback.InteractiveStreams()
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		return nil
+	}
+
+	conn, err := monitor.Dial()
+	if err != nil {
+		feedback.Fatal("unable to connect to monitor: "+err.Error(), feedback.ErrGeneric)
+		return nil
+	}
+	defer conn.Close()
+
+	feedback.Warnf("Connected to the monitor. Press Ctrl+C to exit.")
+
+	go func() {
+		// Closing the connection unblocks the reader below.
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	go func() {
+		if err := monitor.ForwardInput(conn, stdin, lineEnding); err != nil && !errors.Is(err, net.ErrClosed) {
+			feedback.Warnf("error sending data to the monitor: %s", err)
+		}
+	}()
+
+	_, err = io.Copy(monitor.NewOutputWriter(stdout, encoding, timestamps), conn)
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
 }
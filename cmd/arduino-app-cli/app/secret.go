@@ -0,0 +1,151 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/secrets"
+)
+
+func newSecretCmd(cfg config.Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Manage the secrets of an app",
+		Long: "Manage the secrets of an app. Secrets are stored encrypted outside of the app folder, " +
+			"and can be referenced from the brick variables in app.yaml with ${secret:<name>}.",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list app_path",
+		Short: "List the names of the secrets of the app",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			names, err := secrets.NewStore(cfg.DataDir()).Names(app.FullPath.String())
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return nil
+			}
+			feedback.PrintResult(secretListResult{Names: names})
+			return nil
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "set app_path name [value]",
+		Short: "Set a secret of the app",
+		Long:  "Set a secret of the app. If the value is not given as argument it is read from the standard input.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			var value string
+			if len(args) == 3 {
+				value = args[2]
+			} else {
+				stdin, _, err := feedback.InteractiveStreams()
+				if err != nil {
+					feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+					return nil
+				}
+				data, err := io.ReadAll(stdin)
+				if err != nil {
+					feedback.Fatal(err.Error(), feedback.ErrGeneric)
+					return nil
+				}
+				value = strings.TrimRight(string(data), "\r\n")
+			}
+			if err := secrets.NewStore(cfg.DataDir()).Set(app.FullPath.String(), args[1], value); err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return nil
+			}
+			feedback.PrintResult(secretResult{Name: args[1], Message: "Secret set"})
+			return nil
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "delete app_path name",
+		Short: "Delete a secret of the app",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			found, err := secrets.NewStore(cfg.DataDir()).Delete(app.FullPath.String(), args[1])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return nil
+			}
+			if !found {
+				feedback.Fatal(fmt.Sprintf("secret %q not found", args[1]), feedback.ErrBadArgument)
+				return nil
+			}
+			feedback.PrintResult(secretResult{Name: args[1], Message: "Secret deleted"})
+			return nil
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	})
+
+	return cmd
+}
+
+type secretListResult struct {
+	Names []string `json:"names"`
+}
+
+func (r secretListResult) String() string {
+	if len(r.Names) == 0 {
+		return "No secrets set"
+	}
+	return strings.Join(r.Names, "\n")
+}
+
+func (r secretListResult) Data() interface{} {
+	return r
+}
+
+type secretResult struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+func (r secretResult) String() string {
+	return fmt.Sprintf("%s: %s", r.Message, r.Name)
+}
+
+func (r secretResult) Data() interface{} {
+	return r
+}
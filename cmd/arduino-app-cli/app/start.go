@@ -17,7 +17,9 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/text/cases"
@@ -32,7 +34,8 @@ import (
 )
 
 func newStartCmd(cfg config.Configuration) *cobra.Command {
-	return &cobra.Command{
+	var watch, force bool
+	cmd := &cobra.Command{
 		Use:   "start app_path",
 		Short: "Start an Arduino App",
 		Args:  cobra.MaximumNArgs(1),
@@ -40,20 +43,33 @@ func newStartCmd(cfg config.Configuration) *cobra.Command {
 			if len(args) == 0 {
 				return cmd.Help()
 			}
+			if watch && feedback.GetFormat() != feedback.Text {
+				feedback.Fatal("--watch is available only in text format", feedback.ErrBadArgument)
+				return nil
+			}
 			app, err := Load(args[0])
 			if err != nil {
 				return err
 			}
-			return startHandler(cmd.Context(), cfg, app)
+			if err := startHandler(cmd.Context(), cfg, app, force); err != nil {
+				return err
+			}
+			if watch {
+				return watchHandler(cmd.Context(), cfg, app)
+			}
+			return nil
 		},
 		ValidArgsFunction: completion.ApplicationNamesWithFilterFunc(cfg, func(apps orchestrator.AppInfo) bool {
 			return apps.Status != orchestrator.StatusStarting &&
 				apps.Status != orchestrator.StatusRunning
 		}),
 	}
+	cmd.Flags().BoolVar(&watch, "watch", false, "Keep watching the app and reload it when its Python code or sketch change")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite the sketch of another app stored in the flash of the micro")
+	return cmd
 }
 
-func startHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp) error {
+func startHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp, force bool) error {
 	out, _, getResult := feedback.OutputStreams()
 
 	stream := orchestrator.StartApp(
@@ -65,6 +81,7 @@ func startHandler(ctx context.Context, cfg config.Configuration, app app.Arduino
 		app,
 		cfg,
 		servicelocator.GetStaticStore(),
+		force,
 	)
 	for message := range stream {
 		switch message.GetType() {
@@ -74,7 +91,7 @@ func startHandler(ctx context.Context, cfg config.Configuration, app app.Arduino
 			fmt.Fprintln(out, "[INFO]", message.GetData())
 		case orchestrator.ErrorType:
 			errMesg := cases.Title(language.AmericanEnglish).String(message.GetError().Error())
-			feedback.Fatal(fmt.Sprintf("[ERROR] %s", errMesg), feedback.ErrGeneric)
+			feedback.Fatal(fmt.Sprintf("[ERROR] %s%s", errMesg, flashOwnedHint(message.GetError())), feedback.ErrGeneric)
 			return nil
 		}
 	}
@@ -88,6 +105,45 @@ func startHandler(ctx context.Context, cfg config.Configuration, app app.Arduino
 	return nil
 }
 
+func watchHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp) error {
+	// The watch never ends, the output is streamed directly instead of being buffered.
+	out, stderr, err := feedback.DirectStreams()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Watching the app %q for changes, press Ctrl+C to stop\n", app.Name)
+	for changes := range orchestrator.WatchAppChanges(ctx, app, watchInterval) {
+		stream := orchestrator.ReloadApp(ctx, servicelocator.GetDockerClient(), app, cfg, changes)
+		for message := range stream {
+			switch message.GetType() {
+			case orchestrator.ProgressType:
+				fmt.Fprintf(out, "Progress[%s]: %.0f%%\n", message.GetProgress().Name, message.GetProgress().Progress)
+			case orchestrator.InfoType:
+				fmt.Fprintln(out, "[INFO]", message.GetData())
+			case orchestrator.DiagnosticType:
+				d := message.GetDiagnostic()
+				fmt.Fprintf(out, "%s:%d:%d: %s: %s\n", d.File, d.Line, d.Column, d.Severity, d.Message)
+			case orchestrator.ErrorType:
+				// Keep watching, the error could be fixed by the next change.
+				fmt.Fprintln(stderr, "[ERROR]", message.GetError().Error())
+			}
+		}
+	}
+	return nil
+}
+
+const watchInterval = time.Second
+
+// flashOwnedHint tells how to overwrite the sketch of another app stored in the flash.
+func flashOwnedHint(err error) string {
+	var flashOwned *orchestrator.FlashOwnedError
+	if errors.As(err, &flashOwned) {
+		return ", use --force to overwrite it"
+	}
+	return ""
+}
+
 type startAppResult struct {
 	AppName string                        `json:"appName"`
 	Status  string                        `json:"status"`
@@ -41,7 +41,7 @@ func newStopCmd(cfg config.Configuration) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return stopHandler(cmd.Context(), app)
+			return stopHandler(cmd.Context(), cfg, app)
 		},
 		ValidArgsFunction: completion.ApplicationNamesWithFilterFunc(cfg, func(apps orchestrator.AppInfo) bool {
 			return apps.Status == orchestrator.StatusStarting ||
@@ -50,10 +50,10 @@ func newStopCmd(cfg config.Configuration) *cobra.Command {
 	}
 }
 
-func stopHandler(ctx context.Context, app app.ArduinoApp) error {
+func stopHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp) error {
 	out, _, getResult := feedback.OutputStreams()
 
-	for message := range orchestrator.StopApp(ctx, app) {
+	for message := range orchestrator.StopApp(ctx, app, cfg) {
 		switch message.GetType() {
 		case orchestrator.ProgressType:
 			fmt.Fprintf(out, "Progress[%s]: %.0f%%\n", message.GetProgress().Name, message.GetProgress().Progress)
@@ -0,0 +1,99 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newValidateCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate app_path",
+		Short: "Validate the app descriptor",
+		Long:  "Check the app.yaml of the app, reporting unknown keys, bricks, models, devices and invalid ports.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			validateHandler(args[0])
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+func validateHandler(idOrPath string) {
+	// The app is not loaded, so that broken apps can be validated too.
+	id, err := servicelocator.GetAppIDProvider().ParseID(idOrPath)
+	if err != nil {
+		feedback.Fatal(fmt.Sprintf("invalid app path: %s", idOrPath), feedback.ErrBadArgument)
+		return
+	}
+
+	res, err := orchestrator.ValidateApp(id.ToPath(), servicelocator.GetBricksIndex(), servicelocator.GetModelsIndex())
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		return
+	}
+
+	if !res.Valid {
+		feedback.FatalResult(validateAppResult{res}, feedback.ErrGeneric)
+		return
+	}
+	feedback.PrintResult(validateAppResult{res})
+}
+
+type validateAppResult struct {
+	orchestrator.AppValidationResult
+}
+
+func (r validateAppResult) String() string {
+	if len(r.Diagnostics) == 0 {
+		return fmt.Sprintf("%s: no problems found", r.File)
+	}
+	var b strings.Builder
+	for _, d := range r.Diagnostics {
+		if d.Line > 0 {
+			fmt.Fprintf(&b, "%s:%d:%d: %s: %s\n", r.File, d.Line, d.Column, d.Severity, d.Message)
+		} else {
+			fmt.Fprintf(&b, "%s: %s: %s\n", r.File, d.Severity, d.Message)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r validateAppResult) ErrorString() string {
+	if r.Valid {
+		return ""
+	}
+	errorsCount := 0
+	for _, d := range r.Diagnostics {
+		if d.Severity == orchestrator.DiagnosticError {
+			errorsCount++
+		}
+	}
+	return fmt.Sprintf("app validation failed with %d error(s)", errorsCount)
+}
+
+func (r validateAppResult) Data() interface{} {
+	return r.AppValidationResult
+}
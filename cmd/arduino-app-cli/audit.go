@@ -0,0 +1,136 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package main
+
+import (
+	"errors"
+	"log/slog"
+	"os/user"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/audit"
+)
+
+// auditedCommand describes a command changing the apps or the system.
+type auditedCommand struct {
+	// app is the position of the app among the arguments, -1 if none.
+	app int
+	// secrets is the position of the first argument holding a secret, 0 if none.
+	secrets int
+	// readOnly reports whether the arguments select a read-only mode of the command.
+	readOnly func(args []string) bool
+}
+
+var auditedCommands = map[string]auditedCommand{
+	"app new":             {app: -1},
+	"app start":           {app: 0},
+	"app stop":            {app: 0},
+	"app restart":         {app: 0},
+	"app deploy":          {app: 0},
+	"app import":          {app: -1},
+	"app secret set":      {app: 0, secrets: 2},
+	"app secret delete":   {app: 0},
+	"auth desktop-token":  {app: -1},
+	"auth token create":   {app: -1},
+	"auth token revoke":   {app: -1},
+	"config set":          {app: -1},
+	"model add":           {app: -1},
+	"model remove":        {app: -1},
+	"properties set":      {app: 1},
+	"system init":         {app: -1},
+	"system update":       {app: -1},
+	"system cleanup":      {app: -1},
+	"system set-name":     {app: -1},
+	"system assets use":   {app: -1},
+	"system assets prune": {app: -1},
+	"system bundle load":  {app: -1},
+	"system network-mode": {app: -1, readOnly: func(args []string) bool { return slices.Equal(args, []string{"status"}) }},
+	"system keyboard":     {app: -1, readOnly: func(args []string) bool { return len(args) == 0 }},
+}
+
+// pendingAudit is the entry of the running command, written when the command ends.
+var pendingAudit *audit.Entry
+
+// startAudit prepares the audit entry of the command, if it changes the apps or the system.
+func startAudit(cmd *cobra.Command, args []string) {
+	operation := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	audited, ok := auditedCommands[operation]
+	if !ok || (audited.readOnly != nil && audited.readOnly(args)) {
+		return
+	}
+
+	params := map[string]any{}
+	if len(args) > 0 {
+		values := slices.Clone(args)
+		for i := range values {
+			if audited.secrets > 0 && i >= audited.secrets {
+				values[i] = audit.Redacted
+			}
+		}
+		params["args"] = values
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		params[f.Name] = f.Value.String()
+	})
+
+	entry := &audit.Entry{
+		Source:    audit.SourceCLI,
+		Operation: operation,
+		Caller:    cliCaller(),
+		Params:    audit.Redact(params),
+	}
+	if audited.app >= 0 && audited.app < len(args) {
+		if id, err := servicelocator.GetAppIDProvider().ParseID(args[audited.app]); err == nil {
+			entry.AppID = id.String()
+		}
+	}
+	pendingAudit = entry
+
+	feedback.SetExitHook(func(errorMsg string, exitCode feedback.ExitCode) {
+		finishAudit(errors.New(errorMsg))
+	})
+}
+
+// finishAudit writes the entry of the command with its result.
+func finishAudit(err error) {
+	if pendingAudit == nil {
+		return
+	}
+	entry := *pendingAudit
+	pendingAudit = nil
+	entry.Result = audit.ResultSuccess
+	if err != nil {
+		entry.Result = audit.ResultFailure
+		entry.Error = err.Error()
+	}
+	if err := servicelocator.GetAuditLog().Append(entry); err != nil {
+		slog.Warn("Unable to write the audit log", slog.String("error", err.Error()))
+	}
+}
+
+func cliCaller() string {
+	u, err := user.Current()
+	if err != nil {
+		return "user:unknown"
+	}
+	return "user:" + u.Username
+}
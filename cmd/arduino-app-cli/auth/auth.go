@@ -0,0 +1,207 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/auth"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
+)
+
+func NewAuthCmd(cfg config.Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage the authentication of the HTTP API",
+	}
+	tokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage the tokens used to call the HTTP API",
+	}
+	tokenCmd.AddCommand(newTokenCreateCmd(cfg))
+	tokenCmd.AddCommand(newTokenListCmd())
+	tokenCmd.AddCommand(newTokenRevokeCmd())
+	cmd.AddCommand(tokenCmd)
+	cmd.AddCommand(newDesktopTokenCmd(cfg))
+	return cmd
+}
+
+func newTokenCreateCmd(cfg config.Configuration) *cobra.Command {
+	var scopes []string
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Issue a new token",
+		Long: "Issue a new token with the given scopes: read-only, apps and system. " +
+			"The token is printed only once, pass it in the Authorization header as 'Bearer <token>'.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			parsed := make([]auth.Scope, 0, len(scopes))
+			for _, s := range scopes {
+				scope, err := auth.ParseScope(s)
+				if err != nil {
+					feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+					return
+				}
+				parsed = append(parsed, scope)
+			}
+			value, token, err := servicelocator.GetTokenStore().Create(args[0], parsed)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(tokenCreateResult{Token: value, ID: token.ID, Scopes: token.Scopes, AuthEnabled: cfg.Auth.Enabled})
+		},
+	}
+	cmd.Flags().StringSliceVar(&scopes, "scope", []string{string(auth.ScopeReadOnly)}, "Scopes granted to the token: read-only, apps, system")
+	return cmd
+}
+
+type tokenCreateResult struct {
+	ID          string       `json:"id"`
+	Token       string       `json:"token"`
+	Scopes      []auth.Scope `json:"scopes"`
+	AuthEnabled bool         `json:"auth_enabled"`
+}
+
+func (r tokenCreateResult) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Token %s created, store it now since it cannot be shown again:\n%s\n", r.ID, r.Token)
+	if !r.AuthEnabled {
+		b.WriteString("The authentication is not enabled, enable it with 'arduino-app-cli config set auth.enabled true'.\n")
+	}
+	return b.String()
+}
+
+func (r tokenCreateResult) Data() interface{} {
+	return r
+}
+
+func newTokenListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the issued tokens",
+		Args:  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			tokens, err := servicelocator.GetTokenStore().List()
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			res := tokenListResult{Tokens: make([]tokenInfo, 0, len(tokens))}
+			for _, t := range tokens {
+				res.Tokens = append(res.Tokens, tokenInfo{ID: t.ID, Name: t.Name, Scopes: t.Scopes, CreatedAt: t.CreatedAt})
+			}
+			feedback.PrintResult(res)
+		},
+	}
+}
+
+type tokenInfo struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Scopes    []auth.Scope `json:"scopes"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type tokenListResult struct {
+	Tokens []tokenInfo `json:"tokens"`
+}
+
+func (r tokenListResult) String() string {
+	if len(r.Tokens) == 0 {
+		return "No tokens issued."
+	}
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"ID", "NAME", "SCOPES", "CREATED"})
+	for _, token := range r.Tokens {
+		scopes := make([]string, len(token.Scopes))
+		for i, s := range token.Scopes {
+			scopes[i] = string(s)
+		}
+		t.AppendRow(table.Row{token.ID, token.Name, strings.Join(scopes, ", "), token.CreatedAt.Local().Format(time.DateTime)})
+	}
+	return t.Render()
+}
+
+func (r tokenListResult) Data() interface{} {
+	return r
+}
+
+func newTokenRevokeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke a token",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := servicelocator.GetTokenStore().Revoke(args[0]); err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return
+			}
+			feedback.Printf("Token %s revoked", args[0])
+		},
+	}
+}
+
+func newDesktopTokenCmd(cfg config.Configuration) *cobra.Command {
+	var renew bool
+	cmd := &cobra.Command{
+		Use:   "desktop-token",
+		Short: "Print the token identifying the desktop client",
+		Long: "Print the token identifying the desktop client, issuing it if it does not exist yet. " +
+			"The desktop client passes it as any other token, it is accepted only when auth.desktop_client_exempt is set.",
+		Args: cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			desktop := servicelocator.GetDesktopToken()
+			get := desktop.Get
+			if renew {
+				get = desktop.Renew
+			}
+			value, err := get()
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(desktopTokenResult{Token: value, Exempt: cfg.Auth.DesktopClientExempt})
+		},
+	}
+	cmd.Flags().BoolVar(&renew, "renew", false, "Issue a new token, the previous one is not accepted anymore")
+	return cmd
+}
+
+type desktopTokenResult struct {
+	Token  string `json:"token"`
+	Exempt bool   `json:"desktop_client_exempt"`
+}
+
+func (r desktopTokenResult) String() string {
+	if !r.Exempt {
+		return r.Token + "\nThe desktop client is not exempt, enable it with 'arduino-app-cli config set auth.desktop_client_exempt true'."
+	}
+	return r.Token
+}
+
+func (r desktopTokenResult) Data() interface{} {
+	return r
+}
@@ -17,13 +17,17 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 
 	"github.com/arduino/arduino-app-cli/cmd/feedback"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
 )
 
 func NewConfigCmd(cfg config.Configuration) *cobra.Command {
@@ -33,20 +37,94 @@ func NewConfigCmd(cfg config.Configuration) *cobra.Command {
 	}
 
 	appCmd.AddCommand(newConfigGetCmd(cfg))
+	appCmd.AddCommand(newConfigListCmd(cfg))
+	appCmd.AddCommand(newConfigSetCmd(cfg))
 
 	return appCmd
 }
 
 func newConfigGetCmd(cfg config.Configuration) *cobra.Command {
 	return &cobra.Command{
-		Use:   "get",
-		Short: "get configuration",
+		Use:       "get [key]",
+		Short:     "get configuration",
+		Long:      "Print the directories used, or the effective value of a setting, like daemon.port.",
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: config.SettingsKeys(),
 		Run: func(cmd *cobra.Command, args []string) {
-			getConfigHandler(cfg)
+			if len(args) == 0 {
+				getConfigHandler(cfg)
+				return
+			}
+			value, err := cfg.Get(args[0])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return
+			}
+			feedback.PrintResult(settingResult{Key: args[0], Value: value})
 		},
 	}
 }
 
+func newConfigListCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the effective settings",
+		Args:  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			feedback.PrintResult(settingsResult{Settings: cfg.List()})
+		},
+	}
+}
+
+func newConfigSetCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "Set a setting in the configuration file of the user",
+		Long: "Set a setting in the configuration file of the user, the change is applied from the next start. " +
+			"The value is parsed as YAML, lists are written as [a, b].",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: config.SettingsKeys(),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetUserSetting(cfg, args[0], args[1]); err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return
+			}
+			feedback.Printf("Setting %s saved in %s, it will be applied from the next start", args[0], cfg.ConfigFile())
+		},
+	}
+}
+
+type settingResult struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (r settingResult) String() string {
+	return r.Value
+}
+
+func (r settingResult) Data() interface{} {
+	return r
+}
+
+type settingsResult struct {
+	Settings map[string]string
+}
+
+func (r settingsResult) String() string {
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"KEY", "VALUE"})
+	for _, key := range slices.Sorted(maps.Keys(r.Settings)) {
+		t.AppendRow(table.Row{key, r.Settings[key]})
+	}
+	return t.Render()
+}
+
+func (r settingsResult) Data() interface{} {
+	return r.Settings
+}
+
 func getConfigHandler(cfg config.Configuration) {
 	feedback.PrintResult(configResult{
 		Config: orchestrator.GetOrchestratorConfig(cfg),
@@ -35,12 +35,16 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/update/arduino"
 )
 
+const assetsWatchInterval = 5 * time.Second
+
 func NewDaemonCmd(cfg config.Configuration, version string) *cobra.Command {
 	daemonCmd := &cobra.Command{
 		Use:   "daemon",
 		Short: "Run the Arduino App CLI as an HTTP daemon",
 		Run: func(cmd *cobra.Command, args []string) {
 			daemonPort, _ := cmd.Flags().GetString("port")
+			// The flag overrides the configuration, the effective value is returned by the API.
+			cfg.Daemon.Port = daemonPort
 
 			// start the default app in the background
 			go func() {
@@ -62,10 +66,13 @@ func NewDaemonCmd(cfg config.Configuration, version string) *cobra.Command {
 				}
 			}()
 
+			// enforce the restart policy of the apps
+			go orchestrator.NewSupervisor(servicelocator.GetDockerClient(), cfg).Run(cmd.Context())
+
 			httpHandler(cmd.Context(), cfg, daemonPort, version)
 		},
 	}
-	daemonCmd.Flags().String("port", "8080", "The TCP port the daemon will listen to")
+	daemonCmd.Flags().String("port", cfg.Daemon.Port, "The TCP port the daemon will listen to, overrides daemon.port of the configuration")
 	return daemonCmd
 }
 
@@ -73,13 +80,7 @@ func httpHandler(ctx context.Context, cfg config.Configuration, daemonPort, vers
 	slog.Info("Starting HTTP server", slog.String("address", ":"+daemonPort))
 
 	corsConfig := cors.Config{
-		Origins: []string{
-			"wails://wails",
-			"wails://wails.localhost:*",
-			"http://wails.localhost:*",
-			"http://localhost:*",
-			"https://localhost:*",
-		},
+		Origins: cfg.Daemon.CORSOrigins,
 		Methods: []string{
 			http.MethodGet,
 			http.MethodPost,
@@ -105,27 +106,37 @@ func httpHandler(ctx context.Context, cfg config.Configuration, daemonPort, vers
 			arduino.NewArduinoPlatformUpdater(),
 		),
 		servicelocator.GetProvisioner(),
-		servicelocator.GetStaticStore(),
-		servicelocator.GetModelsIndex(),
-		servicelocator.GetBricksIndex(),
-		servicelocator.GetBrickService(),
+		servicelocator.GetAssetsRegistry(),
 		servicelocator.GetAppIDProvider(),
 		cfg,
 		corsConfig.Origins,
+		servicelocator.GetAuditLog(),
 	)
 
+	// Reload the bricks and models indexes when the assets change, keeping track of the
+	// version served so that a prune does not remove it.
+	servicelocator.GetAssetsRegistry().TrackLoadedVersion()
+	go servicelocator.GetAssetsRegistry().Watch(ctx, assetsWatchInterval)
+
+	// Require a token, when enabled, before serving the requests, and record the requests
+	// changing the apps or the system, including the ones rejected by the authentication.
+	auditMiddleware := api.AuditMiddleware(servicelocator.GetAuditLog(), servicelocator.GetBricksIndex)
+	localSrv := auditMiddleware(api.AuthMiddleware(servicelocator.GetTokenStore(), servicelocator.GetDesktopToken(), cfg.Auth)(apiSrv))
+	if !cfg.Auth.Enabled {
+		slog.Warn("The HTTP API is not authenticated, enable it with 'arduino-app-cli config set auth.enabled true'")
+	}
+
 	// Wrap the API server with CORS middleware
 	corsMiddlware, err := cors.NewMiddleware(corsConfig)
 	if err != nil {
 		panic(err)
 	}
-	apiSrv = corsMiddlware.Wrap(apiSrv)
 
 	// Start the HTTP server
 	address := "127.0.0.1:" + daemonPort
 	httpSrv := http.Server{
 		Addr:              address,
-		Handler:           httprecover.RecoverPanic(apiSrv),
+		Handler:           httprecover.RecoverPanic(corsMiddlware.Wrap(localSrv)),
 		ReadHeaderTimeout: 60 * time.Second,
 	}
 	go func() {
@@ -134,11 +145,38 @@ func httpHandler(ctx context.Context, cfg config.Configuration, daemonPort, vers
 		}
 	}()
 
+	// Start the TLS server on the local network, the token is always required there
+	// because the origin of the requests cannot be trusted.
+	var remoteSrv *http.Server
+	if cfg.Daemon.Remote.Enabled {
+		remoteAuth := config.AuthSettings{Enabled: true}
+		srv, fingerprint, err := newRemoteServer(cfg, httprecover.RecoverPanic(corsMiddlware.Wrap(
+			auditMiddleware(api.AuthMiddleware(servicelocator.GetTokenStore(), servicelocator.GetDesktopToken(), remoteAuth)(apiSrv)),
+		)))
+		if err != nil {
+			slog.Error("Failed to start the remote listener", slog.String("error", err.Error()))
+		} else {
+			remoteSrv = srv
+			slog.Info("Starting HTTPS server", slog.String("address", remoteSrv.Addr), slog.String("fingerprint", fingerprint))
+			go func() {
+				if err := remoteSrv.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					slog.Error("HTTPS server failed", slog.String("error", err.Error()))
+				}
+			}()
+			if cfg.Daemon.Remote.Advertise {
+				go advertise(ctx, cfg.Daemon.Remote.Port, fingerprint, version)
+			}
+		}
+	}
+
 	<-ctx.Done()
 	slog.Info("Shutting down HTTP server", slog.String("address", address))
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	_ = httpSrv.Shutdown(ctx)
+	if remoteSrv != nil {
+		_ = remoteSrv.Shutdown(ctx)
+	}
 	cancel()
 	slog.Info("HTTP server shut down", slog.String("address", address))
 }
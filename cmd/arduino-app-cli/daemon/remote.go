@@ -0,0 +1,79 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tlscert"
+)
+
+// avahiServiceType is the DNS-SD service type used to discover the boards on the network.
+const avahiServiceType = "_arduino-app-cli._tcp"
+
+// newRemoteServer creates the TLS server listening on the local network.
+func newRemoteServer(cfg config.Configuration, handler http.Handler) (*http.Server, string, error) {
+	cert, err := tlscert.ForRemote(cfg)
+	if err != nil {
+		return nil, "", fmt.Errorf("loading the TLS certificate: %w", err)
+	}
+	fingerprint, err := tlscert.Fingerprint(cert)
+	if err != nil {
+		return nil, "", err
+	}
+	return &http.Server{
+		Addr:              ":" + cfg.Daemon.Remote.Port,
+		Handler:           handler,
+		ReadHeaderTimeout: 60 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{cert},
+		},
+	}, fingerprint, nil
+}
+
+// advertise publishes the remote listener with avahi, until the context is done. The
+// fingerprint is part of the TXT record, so that the clients can pin the certificate.
+func advertise(ctx context.Context, port, fingerprint, version string) {
+	name, err := os.Hostname()
+	if err != nil {
+		name = "arduino-app-cli"
+	}
+	process, err := paths.NewProcess(nil,
+		"avahi-publish-service", name, avahiServiceType, port,
+		"version="+version,
+		"fingerprint=sha256:"+fingerprint,
+	)
+	if err != nil {
+		slog.Warn("Cannot advertise the remote listener", slog.String("error", err.Error()))
+		return
+	}
+	slog.Info("Advertising the remote listener", slog.String("name", name), slog.String("type", avahiServiceType))
+	out, err := process.RunAndCaptureCombinedOutput(ctx)
+	if ctx.Err() == nil {
+		slog.Warn("The advertisement of the remote listener stopped, check that avahi-daemon is running",
+			slog.Any("error", err), slog.String("output", string(out)))
+	}
+}
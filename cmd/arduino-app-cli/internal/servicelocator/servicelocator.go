@@ -25,8 +25,11 @@ import (
 	dockerClient "github.com/docker/docker/client"
 	"go.bug.st/f"
 
+	"github.com/arduino/arduino-app-cli/internal/audit"
+	"github.com/arduino/arduino-app-cli/internal/auth"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
@@ -41,13 +44,19 @@ func Init(cfg config.Configuration) {
 }
 
 var (
-	GetBricksIndex = sync.OnceValue(func() *bricksindex.BricksIndex {
-		return f.Must(bricksindex.GenerateBricksIndexFromFile(GetStaticStore().GetAssetsFolder()))
+	GetAssetsRegistry = sync.OnceValue(func() *assets.Registry {
+		return f.Must(assets.NewRegistry(globalConfig))
 	})
 
-	GetModelsIndex = sync.OnceValue(func() *modelsindex.ModelsIndex {
-		return f.Must(modelsindex.GenerateModelsIndexFromFile(GetStaticStore().GetAssetsFolder()))
-	})
+	// The following getters return the current assets, that are swapped on reload.
+
+	GetBricksIndex = func() *bricksindex.BricksIndex {
+		return GetAssetsRegistry().Get().BricksIndex
+	}
+
+	GetModelsIndex = func() *modelsindex.ModelsIndex {
+		return GetAssetsRegistry().Get().ModelsIndex
+	}
 
 	GetProvisioner = sync.OnceValue(func() *orchestrator.Provision {
 		return f.Must(orchestrator.NewProvision(
@@ -80,16 +89,24 @@ var (
 		return nil
 	}
 
-	GetStaticStore = sync.OnceValue(func() *store.StaticStore {
-		return store.NewStaticStore(globalConfig.AssetsDir().Join(globalConfig.UsedPythonImageTag).String())
+	GetStaticStore = func() *store.StaticStore {
+		return GetAssetsRegistry().Get().StaticStore
+	}
+
+	GetBrickService = func() *bricks.Service {
+		return GetAssetsRegistry().Get().BrickService
+	}
+
+	GetTokenStore = sync.OnceValue(func() *auth.Store {
+		return auth.NewStore(globalConfig.TokensFile())
+	})
+
+	GetDesktopToken = sync.OnceValue(func() *auth.DesktopToken {
+		return auth.NewDesktopToken(globalConfig.DesktopTokenFile())
 	})
 
-	GetBrickService = sync.OnceValue(func() *bricks.Service {
-		return bricks.NewService(
-			GetModelsIndex(),
-			GetBricksIndex(),
-			GetStaticStore(),
-		)
+	GetAuditLog = sync.OnceValue(func() *audit.Log {
+		return audit.NewLog(globalConfig.AuditFile(), globalConfig.Audit.MaxSizeBytes(), globalConfig.Audit.MaxFiles)
 	})
 
 	GetAppIDProvider = sync.OnceValue(func() *app.IDProvider {
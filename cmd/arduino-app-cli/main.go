@@ -25,11 +25,13 @@ import (
 	"go.bug.st/cleanup"
 
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/app"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/auth"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/brick"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/config"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/daemon"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/model"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/properties"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/system"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/version"
@@ -61,6 +63,11 @@ func run(configuration cfg.Configuration) error {
 				feedback.FatalError(err, feedback.ErrBadArgument)
 			}
 			slog.SetLogLoggerLevel(logLevel)
+
+			startAudit(cmd, args)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			finishAudit(nil)
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -71,9 +78,11 @@ func run(configuration cfg.Configuration) error {
 
 	rootCmd.AddCommand(
 		app.NewAppCmd(configuration),
+		auth.NewAuthCmd(configuration),
 		brick.NewBrickCmd(configuration),
 		completion.NewCompletionCommand(),
 		daemon.NewDaemonCmd(configuration, Version),
+		model.NewModelCmd(configuration),
 		properties.NewPropertiesCmd(configuration),
 		config.NewConfigCmd(configuration),
 		system.NewSystemCmd(configuration),
@@ -0,0 +1,83 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newModelAddCmd(cfg config.Configuration) *cobra.Command {
+	var req orchestrator.AddAIModelRequest
+	cmd := &cobra.Command{
+		Use:   "add model.eim",
+		Short: "Add a custom Edge Impulse model",
+		Long:  "Add a custom Edge Impulse model, that can be selected as the model of the given bricks.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			modelAddHandler(cmd.Context(), cfg, paths.New(args[0]), req)
+		},
+	}
+	cmd.Flags().StringVar(&req.ID, "id", "", "Identifier of the model (default: derived from the name)")
+	cmd.Flags().StringVarP(&req.Name, "name", "n", "", "Name of the model")
+	cmd.Flags().StringVarP(&req.Description, "description", "d", "", "Description of the model")
+	cmd.Flags().StringSliceVarP(&req.Bricks, "brick", "b", nil, "Brick that can use the model, can be repeated")
+	cmd.Flags().StringSliceVarP(&req.Labels, "label", "l", nil, "Label of the model, can be repeated")
+	_ = cmd.MarkFlagRequired("brick")
+	_ = cmd.RegisterFlagCompletionFunc("brick", completion.BrickIDs())
+	return cmd
+}
+
+func modelAddHandler(ctx context.Context, cfg config.Configuration, file *paths.Path, req orchestrator.AddAIModelRequest) {
+	if req.ID == "" && req.Name == "" {
+		req.Name = strings.TrimSuffix(file.Base(), file.Ext())
+	}
+	f, err := file.Open()
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		return
+	}
+	defer f.Close()
+
+	res, err := orchestrator.AddAIModel(ctx, servicelocator.GetDockerClient().Client(), cfg, servicelocator.GetModelsIndex(), servicelocator.GetBricksIndex(), servicelocator.GetStaticStore(), req, f)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+		return
+	}
+	feedback.PrintResult(modelAddResult{Model: res})
+}
+
+type modelAddResult struct {
+	Model orchestrator.AIModelItem `json:"model"`
+}
+
+func (r modelAddResult) String() string {
+	return fmt.Sprintf("Model %q added", r.Model.ID)
+}
+
+func (r modelAddResult) Data() interface{} {
+	return r
+}
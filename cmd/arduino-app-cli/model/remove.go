@@ -0,0 +1,62 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+)
+
+func newModelRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove model_id",
+		Short: "Remove a custom AI model",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := orchestrator.RemoveAIModel(servicelocator.GetModelsIndex(), args[0]); err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(modelRemoveResult{ID: args[0]})
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			var res []string
+			for _, m := range servicelocator.GetModelsIndex().GetModels() {
+				if m.IsCustom() {
+					res = append(res, m.ID)
+				}
+			}
+			return res, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+}
+
+type modelRemoveResult struct {
+	ID string `json:"id"`
+}
+
+func (r modelRemoveResult) String() string {
+	return fmt.Sprintf("Model %q removed", r.ID)
+}
+
+func (r modelRemoveResult) Data() interface{} {
+	return r
+}
@@ -0,0 +1,145 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/helpers"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
+)
+
+func newAssetsCmd(cfg config.Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets",
+		Short: "Manage the provisioned versions of the bricks and models assets",
+	}
+	cmd.AddCommand(newAssetsListCmd(cfg))
+	cmd.AddCommand(newAssetsUseCmd(cfg))
+	cmd.AddCommand(newAssetsPruneCmd(cfg))
+	return cmd
+}
+
+func newAssetsListCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the provisioned versions of the assets",
+		Args:  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, _ []string) {
+			versions, err := assets.ListVersions(cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(assetsListResult{Versions: versions})
+		},
+	}
+}
+
+type assetsListResult struct {
+	Versions []assets.Version `json:"versions"`
+}
+
+func (r assetsListResult) String() string {
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"VERSION", "RUNNER IMAGE", "SIZE", "STATUS"})
+	for _, v := range r.Versions {
+		var status []string
+		if v.InUse {
+			status = append(status, "in use")
+		}
+		if v.Pinned {
+			status = append(status, "pinned")
+		}
+		if v.Default {
+			status = append(status, "default")
+		}
+		t.AppendRow(table.Row{v.Version, v.RunnerImage, helpers.ToHumanMiB(v.Size), strings.Join(status, ", ")})
+	}
+	return t.Render()
+}
+
+func (r assetsListResult) Data() interface{} {
+	return r
+}
+
+func newAssetsUseCmd(cfg config.Configuration) *cobra.Command {
+	var reset bool
+	cmd := &cobra.Command{
+		Use:   "use [version]",
+		Short: "Pin the version of the assets used from the next start",
+		Long:  "Pin the version of the assets, and of the runner image, used from the next start. Use --reset to go back to the version of the installed runner.",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if reset == (len(args) == 1) {
+				feedback.Fatal("either a version or --reset must be given", feedback.ErrBadArgument)
+				return
+			}
+			if reset {
+				if err := assets.UnpinVersion(cfg); err != nil {
+					feedback.Fatal(err.Error(), feedback.ErrGeneric)
+					return
+				}
+				feedback.Printf("Using the assets of the installed runner (%s) from the next start", cfg.RunnerVersion)
+				return
+			}
+			if err := assets.PinVersion(cfg, args[0]); err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return
+			}
+			feedback.Printf("Assets %s pinned, they will be used from the next start", args[0])
+		},
+	}
+	cmd.Flags().BoolVar(&reset, "reset", false, "Remove the pinned version")
+	return cmd
+}
+
+func newAssetsPruneCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune",
+		Short: "Remove the versions of the assets that are not in use, pinned or installed",
+		Args:  cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, _ []string) {
+			res, err := assets.PruneVersions(cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(assetsPruneResult(res))
+		},
+	}
+}
+
+type assetsPruneResult assets.PruneResult
+
+func (r assetsPruneResult) String() string {
+	if len(r.Removed) == 0 && r.SpaceFreed == 0 {
+		return "Nothing to prune."
+	}
+	return fmt.Sprintf("Removed %d versions (%s): %s", len(r.Removed), helpers.ToHumanMiB(r.SpaceFreed), strings.Join(r.Removed, ", "))
+}
+
+func (r assetsPruneResult) Data() interface{} {
+	return r
+}
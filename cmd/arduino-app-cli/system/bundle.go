@@ -0,0 +1,101 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/helpers"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newBundleCmd(cfg config.Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Provision boards without network access",
+	}
+	cmd.AddCommand(newBundleCreateCmd(cfg))
+	cmd.AddCommand(newBundleLoadCmd(cfg))
+	return cmd
+}
+
+func newBundleCreateCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create <file>",
+		Short: "Save the runner and bricks images and the assets into a bundle",
+		Long:  "Save the runner image, the images of the bricks and the assets in use into a bundle. The images must be available locally, run 'system init' first.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := orchestrator.BundleCreate(cmd.Context(), cfg, servicelocator.GetStaticStore(), servicelocator.GetDockerClient(), paths.New(args[0]))
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(bundleCreateResult(res))
+		},
+	}
+}
+
+type bundleCreateResult orchestrator.BundleCreateResult
+
+func (r bundleCreateResult) String() string {
+	return fmt.Sprintf("Bundle %s created (%s) with the assets %s and the images:\n  %s", r.Path, helpers.ToHumanMiB(r.Size), r.Version, strings.Join(r.Images, "\n  "))
+}
+
+func (r bundleCreateResult) Data() interface{} {
+	return r
+}
+
+func newBundleLoadCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <file>",
+		Short: "Load the images and the assets of a bundle",
+		Long:  "Load the images and the assets of a bundle, so that the board is provisioned without reaching the network.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := orchestrator.BundleLoad(cmd.Context(), cfg, servicelocator.GetDockerClient(), paths.New(args[0]))
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(bundleLoadResult{BundleLoadResult: res, InUse: res.Version == cfg.UsedPythonImageTag})
+		},
+	}
+}
+
+type bundleLoadResult struct {
+	orchestrator.BundleLoadResult
+	InUse bool `json:"in_use"`
+}
+
+func (r bundleLoadResult) String() string {
+	msg := fmt.Sprintf("Loaded the assets %s and %d images.", r.Version, len(r.Images))
+	if !r.InUse {
+		msg += fmt.Sprintf(" Run 'arduino-app-cli system assets use %s' to use them from the next start.", r.Version)
+	}
+	return msg
+}
+
+func (r bundleLoadResult) Data() interface{} {
+	return r
+}
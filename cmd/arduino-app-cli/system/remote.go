@@ -0,0 +1,70 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package system
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tlscert"
+)
+
+func newFingerprintCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fingerprint",
+		Short: "Show the fingerprint of the TLS certificate of the remote listener",
+		Long:  "Show the SHA-256 fingerprint of the TLS certificate used by the daemon on the local network, to be compared with the one shown by the clients. The self-signed certificate is generated if missing.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cert, err := tlscert.ForRemote(cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			fingerprint, err := tlscert.Fingerprint(cert)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				return
+			}
+			feedback.PrintResult(fingerprintResult{
+				Fingerprint: fingerprint,
+				Port:        cfg.Daemon.Remote.Port,
+				Enabled:     cfg.Daemon.Remote.Enabled,
+			})
+		},
+	}
+}
+
+type fingerprintResult struct {
+	Fingerprint string `json:"fingerprint"`
+	Port        string `json:"port"`
+	Enabled     bool   `json:"enabled"`
+}
+
+func (r fingerprintResult) String() string {
+	status := "disabled, enable it with 'arduino-app-cli config set daemon.remote.enabled true'"
+	if r.Enabled {
+		status = "listening on port " + r.Port
+	}
+	return fmt.Sprintf("SHA-256 fingerprint: %s\nRemote listener: %s", r.Fingerprint, status)
+}
+
+func (r fingerprintResult) Data() interface{} {
+	return r
+}
@@ -44,6 +44,9 @@ func NewSystemCmd(cfg config.Configuration) *cobra.Command {
 	cmd.AddCommand(newDownloadImageCmd(cfg))
 	cmd.AddCommand(newUpdateCmd())
 	cmd.AddCommand(newCleanUpCmd(cfg, servicelocator.GetDockerClient()))
+	cmd.AddCommand(newAssetsCmd(cfg))
+	cmd.AddCommand(newBundleCmd(cfg))
+	cmd.AddCommand(newFingerprintCmd(cfg))
 	cmd.AddCommand(newNetworkModeCmd())
 	cmd.AddCommand(newKeyboardSetCmd())
 	cmd.AddCommand(newBoardSetNameCmd())
@@ -61,6 +61,7 @@ func ParseOutputFormat(in string) (OutputFormat, bool) {
 }
 
 var (
+	stdIn          io.Reader
 	stdOut         io.Writer
 	stdErr         io.Writer
 	feedbackOut    io.Writer
@@ -79,6 +80,7 @@ func init() {
 
 // reset resets the feedback package to its initial state, useful for unit testing
 func reset() {
+	stdIn = os.Stdin
 	stdOut = os.Stdout
 	stdErr = os.Stderr
 	feedbackOut = os.Stdout
@@ -169,14 +171,30 @@ func FatalError(err error, exitCode ExitCode) {
 	Fatal(err.Error(), exitCode)
 }
 
+var exitHook func(errorMsg string, exitCode ExitCode)
+
+// SetExitHook sets a function called before exiting with an error, the process is
+// terminated before the deferred functions are run.
+func SetExitHook(hook func(errorMsg string, exitCode ExitCode)) {
+	exitHook = hook
+}
+
+func runExitHook(errorMsg string, exitCode ExitCode) {
+	if exitHook != nil {
+		exitHook(errorMsg, exitCode)
+	}
+}
+
 // FatalResult outputs the result and exits with status exitCode.
 func FatalResult(res ErrorResult, exitCode ExitCode) {
 	PrintResult(res)
+	runExitHook(res.ErrorString(), exitCode)
 	os.Exit(int(exitCode))
 }
 
 // Fatal outputs the errorMsg and exits with status exitCode.
 func Fatal(errorMsg string, exitCode ExitCode) {
+	runExitHook(errorMsg, exitCode)
 	if format == Text {
 		fmt.Fprintln(stdErr, errorMsg)
 		os.Exit(int(exitCode))
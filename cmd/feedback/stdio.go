@@ -39,6 +39,19 @@ func DirectStreams() (io.Writer, io.Writer, error) {
 	return stdOut, stdErr, nil
 }
 
+// InteractiveStreams returns the underlying io.Reader and io.Writer to
+// directly stream to stdin and stdout. It errors if the selected output
+// format is not Text.
+func InteractiveStreams() (io.Reader, io.Writer, error) {
+	if !formatSelected {
+		panic("output format not yet selected")
+	}
+	if format != Text {
+		return nil, nil, errors.New(i18n.Tr("available only in text format"))
+	}
+	return stdIn, stdOut, nil
+}
+
 // OutputStreams returns a pair of io.Writer to write the command output.
 // The returned writers will accumulate the output until the command
 // execution is completed, so they are not suitable for printing an unbounded
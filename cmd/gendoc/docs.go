@@ -16,10 +16,12 @@
 package main
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 	"path"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/swaggest/jsonschema-go"
@@ -31,8 +33,10 @@ import (
 
 	"github.com/arduino/arduino-app-cli/internal/api/handlers"
 	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/auth"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
 	"github.com/arduino/arduino-app-cli/internal/update"
 )
@@ -145,6 +149,23 @@ func NewOpenApiGenerator(version string) *Generator {
 						},
 					},
 				},
+				"PayloadTooLarge": {
+					Response: &openapi3.Response{
+						Description: "Payload Too Large",
+						Content: map[string]openapi3.MediaType{
+							"application/json": {
+								Example: f.Ptr(interface{}(map[string]interface{}{
+									"details": "The uploaded content is too large.",
+								})),
+								Schema: &openapi3.SchemaOrRef{
+									SchemaReference: &openapi3.SchemaReference{
+										Ref: ErrorResponseSchema,
+									},
+								},
+							},
+						},
+					},
+				},
 				"NoContent": {
 					Response: &openapi3.Response{
 						Description: "No Content",
@@ -179,6 +200,40 @@ func NewOpenApiGenerator(version string) *Generator {
 						},
 					},
 				},
+				"Unauthorized": {
+					Response: &openapi3.Response{
+						Description: "Unauthorized",
+						Content: map[string]openapi3.MediaType{
+							"application/json": {
+								Example: f.Ptr(interface{}(map[string]interface{}{
+									"details": "A valid token is required.",
+								})),
+								Schema: &openapi3.SchemaOrRef{
+									SchemaReference: &openapi3.SchemaReference{
+										Ref: ErrorResponseSchema,
+									},
+								},
+							},
+						},
+					},
+				},
+				"Forbidden": {
+					Response: &openapi3.Response{
+						Description: "Forbidden",
+						Content: map[string]openapi3.MediaType{
+							"application/json": {
+								Example: f.Ptr(interface{}(map[string]interface{}{
+									"details": "The token does not grant the scope required.",
+								})),
+								Schema: &openapi3.SchemaOrRef{
+									SchemaReference: &openapi3.SchemaReference{
+										Ref: ErrorResponseSchema,
+									},
+								},
+							},
+						},
+					},
+				},
 				"InternalServerError": {
 					Response: &openapi3.Response{
 						Description: "Internal Server Error",
@@ -200,6 +255,13 @@ func NewOpenApiGenerator(version string) *Generator {
 		},
 	)
 
+	reflector.Spec.SetHTTPBearerTokenSecurity(
+		"bearerAuth",
+		"",
+		"Token issued with 'arduino-app-cli auth token create', required when the authentication is enabled. "+
+			"The GET requests can pass it in the access_token query parameter.",
+	)
+
 	// Openapi-go automatically add as prefix the package name. We use this hook
 	// to manually remove the pkg prefix.
 	reflector.DefaultOptions = append(reflector.DefaultOptions,
@@ -240,15 +302,17 @@ func (g *Generator) GetDocs() *openapi3.Spec {
 }
 
 type OperationConfig struct {
-	OperationId    string
-	Method         string
-	Path           string
-	Parameters     interface{}
-	Request        interface{}
-	Description    string
-	Summary        string
-	Tags           []Tag
-	PossibleErrors []ErrorResponse
+	OperationId string
+	Method      string
+	Path        string
+	Parameters  interface{}
+	Request     interface{}
+	// RequestContentType overrides the default (application/json) content type of the request body.
+	RequestContentType string
+	Description        string
+	Summary            string
+	Tags               []Tag
+	PossibleErrors     []ErrorResponse
 
 	CustomSuccessResponse *CustomResponseDef
 }
@@ -417,6 +481,165 @@ func (g *Generator) InitOperations() {
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "exportApp",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{id}/export",
+			Parameters: (*struct {
+				ID          string `path:"id" description:"application identifier."`
+				IncludeData bool   `query:"include_data" description:"If true, the data folder of the app is included in the archive."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/gzip",
+				DataStructure: []byte{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Export the app as a versioned archive (tar.gz). The archive contains a manifest listing the required bricks, models and runner version, followed by the app files. An archive larger than the maximum size accepted by the import is refused.",
+			Summary:     "Export an app",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusRequestEntityTooLarge, Reference: "#/components/responses/PayloadTooLarge"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId:        "importApp",
+			Method:             http.MethodPost,
+			Path:               "/v1/apps/import",
+			Request:            []byte{},
+			RequestContentType: "application/gzip",
+			Parameters: (*struct {
+				Name string `query:"name" description:"Name of the imported app. Defaults to the name stored in the archive."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.ImportAppResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusCreated,
+			},
+			Description: "Import an app from an archive created by the export endpoint. The response reports the bricks and models used by the app that are not available on this board. The archive is limited to 512 MiB, and the extracted files to 2 GiB.",
+			Summary:     "Import an app",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusConflict, Reference: "#/components/responses/Conflict"},
+				{StatusCode: http.StatusRequestEntityTooLarge, Reference: "#/components/responses/PayloadTooLarge"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "validateApp",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{id}/validate",
+			Parameters: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AppValidationResult{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Validate the app.yaml of the app against the bricks and models available on the board. Each diagnostic reports the line and column of the offending node.",
+			Summary:     "Validate an app",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+			},
+		},
+		{
+			OperationId: "getAppRuns",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{id}/runs",
+			Parameters: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AppRunsResult{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Return the history of the last starts and stops of the app, the most recent first. Each run reports its progress phases, its output and the final error.",
+			Summary:     "Get app runs history",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "getAppSecrets",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{id}/secrets",
+			Parameters: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: models.AppSecretNamesResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Return the names of the secrets of the app. Secret values are never returned.",
+			Summary:     "List app secrets",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "upsertAppSecret",
+			Method:      http.MethodPut,
+			Path:        "/v1/apps/{id}/secrets/{name}",
+			Request:     models.AppSecretUpsertRequest{},
+			Parameters: (*struct {
+				ID   string `path:"id" description:"application identifier."`
+				Name string `path:"name" description:"secret name."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				Description: "Successful response",
+				StatusCode:  http.StatusNoContent,
+			},
+			Description: "Create or update a secret of the app. The secret can be referenced from the brick variables in app.yaml with ${secret:<name>}.",
+			Summary:     "Set an app secret",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "deleteAppSecret",
+			Method:      http.MethodDelete,
+			Path:        "/v1/apps/{id}/secrets/{name}",
+			Request: (*struct {
+				ID   string `path:"id" description:"application identifier."`
+				Name string `path:"name" description:"secret name."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				Description: "Successful response",
+				StatusCode:  http.StatusNoContent,
+			},
+			Description: "Delete a secret of the app.",
+			Summary:     "Delete an app secret",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "stopApp",
 			Method:      http.MethodPost,
@@ -459,9 +682,10 @@ Contains a JSON object with the details of an error.
 			Method:      http.MethodPost,
 			Path:        "/v1/apps/{id}/start",
 			Request: (*struct {
-				ID string `path:"id" description:"application identifier."`
+				ID    string `path:"id" description:"application identifier."`
+				Force bool   `query:"force" description:"If true, the sketch of another app stored in the flash of the micro is overwritten."`
 			})(nil),
-			Description: "Start the application and handles all the operation to start any dependecies. If the app contains a sketch it also flash it in the micro.",
+			Description: "Start the application and handles all the operation to start any dependecies. If the app contains a sketch it also flash it in the micro. Unless force is set, the start fails with a FLASH_OWNED error if the upload of the sketch would overwrite the sketch of another app stored in the flash of the micro.",
 			Summary:     "Start an existing app/example",
 			Tags:        []Tag{ApplicationTag},
 			CustomSuccessResponse: &CustomResponseDef{
@@ -480,6 +704,58 @@ Contains a JSON object with an informational message.
 'event: message'
 'data: {"message":"Starting container..."}'
 
+**Event 'diagnostic'**:
+Contains a JSON object with a problem reported by the compiler while building the sketch.
+'event: diagnostic'
+'data: {"severity":"error","message":"'x' was not declared in this scope","file":"sketch/sketch.ino","line":4,"column":3}'
+
+**Event 'error'**:
+Contains a JSON object with the details of an error.
+'event: error'
+'data: {"code":"INTERNAL_SERVER_ERROR","message":"An error occurred during operation"}'
+
+When the upload of the sketch would overwrite the sketch of another app stored in the flash of the micro, the error reports the owner of the flash:
+'event: error'
+'data: {"code":"FLASH_OWNED","message":"the flash of the micro stores the sketch of the app \"blink\", it would be overwritten","owner":"blink"}'
+`,
+			},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "reloadApp",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{id}/reload",
+			Request: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			Description: "Reload the code of a running application. Only the main service is restarted to apply the changes to the Python code, while the sketch is compiled and uploaded again only if it changed. The bricks containers are left untouched.",
+			Summary:     "Reload the code of a running app",
+			Tags:        []Tag{ApplicationTag},
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "text/event-stream",
+				DataStructure: "",
+				Description: `A stream of Server-Sent Events (SSE) that notifies the progress.
+The client will receive events formatted as follows:
+
+**Event 'progress'**:
+Contains a JSON object with the percentage of completion.
+'event: progress'
+'data: {"progress":0.25}'
+
+**Event 'message'**:
+Contains a JSON object with an informational message.
+'event: message'
+'data: {"message":"sketch unchanged, skipping upload"}'
+
+**Event 'diagnostic'**:
+Contains a JSON object with a problem reported by the compiler while building the sketch.
+'event: diagnostic'
+'data: {"severity":"error","message":"'x' was not declared in this scope","file":"sketch/sketch.ino","line":4,"column":3}'
+
 **Event 'error'**:
 Contains a JSON object with the details of an error.
 'event: error'
@@ -681,7 +957,7 @@ Contains a JSON object with the details of an error.
 				Description:   "Successful response",
 				StatusCode:    http.StatusOK,
 			},
-			Description: "Returns all the existing bricks. Bricks that are ready to use are marked as installed.",
+			Description: "Returns all the existing bricks. Bricks that are ready to use are marked as installed, while the bricks authored by the user are marked as custom.",
 			Summary:     "Get a list of available bricks",
 			Tags:        []Tag{BrickTag},
 			PossibleErrors: []ErrorResponse{
@@ -699,7 +975,7 @@ Contains a JSON object with the details of an error.
 				Description:   "Successful response",
 				StatusCode:    http.StatusOK,
 			},
-			Description: "returns information about current directory configuration used by the app",
+			Description: "returns information about current directory configuration used by the app, and the effective settings read from the configuration files and from the environment",
 			Summary:     "returns application configuration",
 			Tags:        []Tag{ApplicationTag},
 			PossibleErrors: []ErrorResponse{
@@ -757,13 +1033,62 @@ Contains a JSON object with the details of an error.
 				Description:   "Successful response",
 				StatusCode:    http.StatusOK,
 			},
-			Description: "Returns the details of a specific AI model.",
+			Description: "Returns the details of a specific AI model, including the parameters read by inspecting the model (sensor, input shape, labels, hardware acceleration) and its compatibility with each of its bricks.",
 			Summary:     "Get AI model details",
 			Tags:        []Tag{AIModelsTag},
 			PossibleErrors: []ErrorResponse{
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId:        "addAIModel",
+			Method:             http.MethodPost,
+			Path:               "/v1/models",
+			Request:            []byte{},
+			RequestContentType: "application/octet-stream",
+			Parameters: (*struct {
+				ID          string `query:"id" description:"Identifier of the model. Defaults to the slug of the name."`
+				Name        string `query:"name" description:"Name of the model."`
+				Description string `query:"description" description:"Description of the model."`
+				Bricks      string `query:"bricks" description:"Comma separated list of the bricks that can use the model."`
+				Labels      string `query:"labels" description:"Comma separated list of the labels of the model."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AIModelItem{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusCreated,
+			},
+			Description: "Upload a custom Edge Impulse model. The body of the request is the .eim file of the model. The model is stored in the custom models folder and it can be used by the given bricks as any other model.",
+			Summary:     "Add a custom AI model",
+			Tags:        []Tag{AIModelsTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusConflict, Reference: "#/components/responses/Conflict"},
+				{StatusCode: http.StatusRequestEntityTooLarge, Reference: "#/components/responses/PayloadTooLarge"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "deleteAIModel",
+			Method:      http.MethodDelete,
+			Path:        "/v1/models/{id}",
+			Request: (*struct {
+				ID string `path:"id" description:"AI model identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				Description: "Successful response",
+				StatusCode:  http.StatusOK,
+			},
+			Description: "Remove a custom AI model. The models shipped with the system cannot be removed.",
+			Summary:     "Delete a custom AI model",
+			Tags:        []Tag{AIModelsTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "getSystemResources",
 			Method:      http.MethodGet,
@@ -844,6 +1169,115 @@ Contains a JSON object with the details of an error.
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "reloadAssets",
+			Method:      http.MethodPost,
+			Path:        "/v1/system/assets/reload",
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: models.AssetsReloadResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Reload the bricks and models indexes from the assets folder and from the custom bricks and models folders. The requests already in progress complete with the previous indexes. The daemon also reloads the assets when it detects a change.",
+			Summary:     "Reload the assets",
+			Tags:        []Tag{SystemTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "listAssets",
+			Method:      http.MethodGet,
+			Path:        "/v1/system/assets",
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: models.AssetsVersionsResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "List the provisioned versions of the assets, with the runner image they belong to, their size on disk and whether they are in use, pinned or the default of the installed runner.",
+			Summary:     "List the assets versions",
+			Tags:        []Tag{SystemTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "pinAssets",
+			Method:      http.MethodPut,
+			Path:        "/v1/system/assets/pin",
+			Request:     models.AssetsPinRequest{},
+			CustomSuccessResponse: &CustomResponseDef{
+				Description: "Successful response",
+				StatusCode:  http.StatusNoContent,
+			},
+			Description: "Pin a provisioned version of the assets. The pinned version, and the runner image it belongs to, are used from the next start of the daemon.",
+			Summary:     "Pin an assets version",
+			Tags:        []Tag{SystemTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "unpinAssets",
+			Method:      http.MethodDelete,
+			Path:        "/v1/system/assets/pin",
+			CustomSuccessResponse: &CustomResponseDef{
+				Description: "Successful response",
+				StatusCode:  http.StatusNoContent,
+			},
+			Description: "Remove the pin, restoring from the next start of the daemon the assets of the installed runner.",
+			Summary:     "Unpin the assets version",
+			Tags:        []Tag{SystemTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "pruneAssets",
+			Method:      http.MethodPost,
+			Path:        "/v1/system/assets/prune",
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: assets.PruneResult{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Remove the provisioned versions of the assets that are not in use, pinned or the default of the installed runner.",
+			Summary:     "Remove the unused assets versions",
+			Tags:        []Tag{SystemTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "listAudit",
+			Method:      http.MethodGet,
+			Path:        "/v1/system/audit",
+			Parameters: (*struct {
+				AppID     string `query:"app_id" description:"only the operations on this application."`
+				Caller    string `query:"caller" description:"only the operations of this caller, like \"token:<id>\" or \"user:<name>\"."`
+				Operation string `query:"operation" description:"only the operations containing this text, like \"/start\" or \"app start\"."`
+				Since     string `query:"since" description:"only the operations performed from this time, in the RFC 3339 format."`
+				Limit     int    `query:"limit" description:"the number of the most recent operations returned, from 1 to 1000, 100 by default."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: models.AuditResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "List the operations changing the apps or the system, performed through the API or the CLI, from the oldest to the newest. The secrets among the parameters are redacted.",
+			Summary:     "List the audit log",
+			Tags:        []Tag{SystemTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "eventsUpdate",
 			Method:      http.MethodGet,
@@ -935,12 +1369,13 @@ Contains a JSON object with the details of an error.
 				Description: "Successful response",
 				StatusCode:  http.StatusOK,
 			},
-			Description: "Upsert a brick instance for an app. If the instance does not exist, it will be created. If it exists, it will be updated.",
+			Description: "Upsert a brick instance for an app. If the instance does not exist, it will be created. If it exists, it will be updated. The bricks the brick depends on are added to the app, while a brick conflicting with the ones of the app is refused. The values of the variables are validated against their type, the invalid ones are reported in the errors field of the Bad Request response.",
 			Summary:     "Upsert a brick instance for an app",
 			Tags:        []Tag{ApplicationTag},
 			PossibleErrors: []ErrorResponse{
 				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
 				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusConflict, Reference: "#/components/responses/Conflict"},
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
@@ -957,7 +1392,7 @@ Contains a JSON object with the details of an error.
 				Description: "Successful response",
 				StatusCode:  http.StatusOK,
 			},
-			Description: "Update a brick instance for an app. It update/add only the provided fields.",
+			Description: "Update a brick instance for an app. It update/add only the provided fields. The values of the variables are validated against their type, the invalid ones are reported in the errors field of the Bad Request response.",
 			Summary:     "Update a brick instance for an app",
 			Tags:        []Tag{ApplicationTag},
 			PossibleErrors: []ErrorResponse{
@@ -1082,6 +1517,49 @@ Contains a JSON object with the details of an error.
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "appSketchCompile",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{appID}/sketch/compile",
+			Parameters: (*struct {
+				ID string `path:"appID" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "text/event-stream",
+				DataStructure: "",
+				Description: `A stream of Server-Sent Events (SSE) that notifies the progress of the compilation.
+The client will receive events formatted as follows:
+
+**Event 'progress'**:
+Contains a JSON object with the percentage of completion.
+'event: progress'
+'data: {"progress":0.25}'
+
+**Event 'message'**:
+Contains a JSON object with a line of the compiler output.
+'event: message'
+'data: {"message":"Compiling sketch..."}'
+
+**Event 'diagnostic'**:
+Contains a JSON object with a problem reported by the compiler.
+'event: diagnostic'
+'data: {"severity":"error","message":"'x' was not declared in this scope","file":"sketch/sketch.ino","line":4,"column":3,"snippet":[{"line":4,"text":"  x = 1;"}]}'
+
+**Event 'error'**:
+Contains a JSON object with the details of an error.
+'event: error'
+'data: {"code":"INTERNAL_SERVER_ERROR","message":"An error occurred during operation"}'
+`,
+			},
+			Description: "Compiles the App' sketch without uploading it to the micro, reporting the compiler diagnostics.",
+			Summary:     "Compiles the App' sketch.",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 	}
 
 	for _, op := range operations {
@@ -1106,10 +1584,23 @@ func (g *Generator) AddOperation(config OperationConfig) error {
 	if err != nil {
 		return err
 	}
+	possibleErrors := config.PossibleErrors
+	if scope, protected := auth.RequiredScope(config.Method, config.Path); protected {
+		opCtx.AddSecurity("bearerAuth")
+		config.Description += fmt.Sprintf("\n\nRequires the `%s` scope when the authentication is enabled.", scope)
+		possibleErrors = append(slices.Clone(possibleErrors),
+			ErrorResponse{StatusCode: http.StatusUnauthorized, Reference: "#/components/responses/Unauthorized"},
+			ErrorResponse{StatusCode: http.StatusForbidden, Reference: "#/components/responses/Forbidden"},
+		)
+	}
 	opCtx.SetDescription(config.Description)
 	opCtx.SetTags(f.Map(config.Tags, func(t Tag) string { return string(t) })...)
 	opCtx.SetSummary(config.Summary)
-	opCtx.AddReqStructure(config.Request)
+	if config.RequestContentType != "" {
+		opCtx.AddReqStructure(config.Request, openapi.WithContentType(config.RequestContentType))
+	} else {
+		opCtx.AddReqStructure(config.Request)
+	}
 	opCtx.SetID(config.OperationId)
 
 	if config.Parameters != nil {
@@ -1121,8 +1612,9 @@ func (g *Generator) AddOperation(config OperationConfig) error {
 		cu.ContentType = config.CustomSuccessResponse.ContentType
 		cu.Description = config.CustomSuccessResponse.Description
 	})
-	for _, e := range config.PossibleErrors {
-		opCtx.AddRespStructure(e, func(cu *openapi.ContentUnit) {
+	for _, e := range possibleErrors {
+		// The structure defines the ErrorResponse schema referenced by the responses.
+		opCtx.AddRespStructure(models.ErrorResponse{}, func(cu *openapi.ContentUnit) {
 			cu.Customize = func(cor openapi.ContentOrReference) {
 				cor.SetReference(e.Reference)
 			}
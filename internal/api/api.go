@@ -20,13 +20,11 @@ import (
 	"net/http"
 
 	"github.com/arduino/arduino-app-cli/internal/api/handlers"
+	"github.com/arduino/arduino-app-cli/internal/audit"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
-	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
-	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
-	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
-	"github.com/arduino/arduino-app-cli/internal/store"
 	"github.com/arduino/arduino-app-cli/internal/update"
 
 	"github.com/docker/cli/cli/command"
@@ -42,21 +40,21 @@ func NewHTTPRouter(
 	version string,
 	updater *update.Manager,
 	provisioner *orchestrator.Provision,
-	staticStore *store.StaticStore,
-	modelsIndex *modelsindex.ModelsIndex,
-	bricksIndex *bricksindex.BricksIndex,
-	brickService *bricks.Service,
+	assetsRegistry *assets.Registry,
 	idProvider *app.IDProvider,
 	cfg config.Configuration,
 	allowedOrigins []string,
+	auditLog *audit.Log,
 ) http.Handler {
 	mux := http.NewServeMux()
 	mux.Handle("GET /debug/", http.DefaultServeMux) // pprof endpoints
 
 	mux.Handle("GET /v1/version", handlers.HandlerVersion(version))
 	mux.Handle("GET /v1/config", handlers.HandleConfig(cfg))
-	mux.Handle("GET /v1/bricks", handlers.HandleBrickList(brickService))
-	mux.Handle("GET /v1/bricks/{brickID}", handlers.HandleBrickDetails(brickService, idProvider, cfg))
+	mux.Handle("GET /v1/bricks", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleBrickList(a.BrickService) }))
+	mux.Handle("GET /v1/bricks/{brickID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleBrickDetails(a.BrickService, idProvider, cfg)
+	}))
 
 	mux.Handle("GET /v1/properties", handlers.HandlePropertyKeys(cfg))
 	mux.Handle("GET /v1/properties/{key}", handlers.HandlePropertyGet(cfg))
@@ -66,32 +64,67 @@ func NewHTTPRouter(
 	mux.Handle("GET /v1/system/update/check", handlers.HandleCheckUpgradable(updater))
 	mux.Handle("GET /v1/system/update/events", handlers.HandleUpdateEvents(updater))
 	mux.Handle("PUT /v1/system/update/apply", handlers.HandleUpdateApply(updater))
-	mux.Handle("GET /v1/system/resources", handlers.HandleSystemResources())
-
-	mux.Handle("GET /v1/models", handlers.HandleModelsList(modelsIndex))
-	mux.Handle("GET /v1/models/{modelID}", handlers.HandlerModelByID(modelsIndex))
+	mux.Handle("GET /v1/system/resources", handlers.HandleSystemResources(cfg))
+	mux.Handle("GET /v1/system/assets", handlers.HandleAssetsList(cfg))
+	mux.Handle("PUT /v1/system/assets/pin", handlers.HandleAssetsPin(cfg))
+	mux.Handle("DELETE /v1/system/assets/pin", handlers.HandleAssetsUnpin(cfg))
+	mux.Handle("POST /v1/system/assets/prune", handlers.HandleAssetsPrune(cfg))
+	mux.Handle("POST /v1/system/assets/reload", handlers.HandleAssetsReload(assetsRegistry))
+	mux.Handle("GET /v1/system/audit", handlers.HandleSystemAudit(auditLog))
+
+	mux.Handle("GET /v1/models", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleModelsList(a.ModelsIndex) }))
+	mux.Handle("GET /v1/models/{modelID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandlerModelByID(a.ModelsIndex, a.BricksIndex) }))
+	mux.Handle("POST /v1/models", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleModelAdd(dockerClient, a.ModelsIndex, a.BricksIndex, a.StaticStore, cfg)
+	}))
+	mux.Handle("DELETE /v1/models/{modelID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleModelDelete(a.ModelsIndex) }))
 
 	mux.Handle("GET /v1/apps", handlers.HandleAppList(dockerClient, idProvider, cfg))
 	mux.Handle("POST /v1/apps", handlers.HandleAppCreate(idProvider, cfg))
 	mux.Handle("GET /v1/apps/events", handlers.HandlerAppStatus(dockerClient, idProvider, cfg))
-
-	mux.Handle("GET /v1/apps/{appID}", handlers.HandleAppDetails(dockerClient, bricksIndex, idProvider, cfg))
-	mux.Handle("PATCH /v1/apps/{appID}", handlers.HandleAppDetailsEdits(dockerClient, bricksIndex, idProvider, cfg))
-	mux.Handle("GET /v1/apps/{appID}/logs", handlers.HandleAppLogs(dockerClient, idProvider, staticStore))
-	mux.Handle("POST /v1/apps/{appID}/start", handlers.HandleAppStart(dockerClient, provisioner, modelsIndex, bricksIndex, idProvider, cfg, staticStore))
-	mux.Handle("POST /v1/apps/{appID}/stop", handlers.HandleAppStop(dockerClient, idProvider))
+	mux.Handle("POST /v1/apps/import", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppImport(a.BricksIndex, a.ModelsIndex, idProvider, cfg)
+	}))
+
+	mux.Handle("GET /v1/apps/{appID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppDetails(dockerClient, a.BricksIndex, idProvider, cfg)
+	}))
+	mux.Handle("PATCH /v1/apps/{appID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppDetailsEdits(dockerClient, a.BricksIndex, idProvider, cfg)
+	}))
+	mux.Handle("GET /v1/apps/{appID}/logs", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppLogs(dockerClient, idProvider, a.StaticStore)
+	}))
+	mux.Handle("POST /v1/apps/{appID}/start", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppStart(dockerClient, provisioner, a.ModelsIndex, a.BricksIndex, idProvider, cfg, a.StaticStore)
+	}))
+	mux.Handle("POST /v1/apps/{appID}/reload", handlers.HandleAppReload(dockerClient, idProvider, cfg))
+	mux.Handle("POST /v1/apps/{appID}/stop", handlers.HandleAppStop(dockerClient, idProvider, cfg))
 	mux.Handle("POST /v1/apps/{appID}/clone", handlers.HandleAppClone(dockerClient, idProvider, cfg))
-	mux.Handle("DELETE /v1/apps/{appID}", handlers.HandleAppDelete(idProvider))
-	mux.Handle("GET /v1/apps/{appID}/exposed-ports", handlers.HandleAppPorts(bricksIndex, idProvider))
+	mux.Handle("DELETE /v1/apps/{appID}", handlers.HandleAppDelete(idProvider, cfg))
+	mux.Handle("GET /v1/apps/{appID}/export", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleAppExport(a.BricksIndex, idProvider, cfg) }))
+	mux.Handle("GET /v1/apps/{appID}/validate", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppValidate(a.BricksIndex, a.ModelsIndex, idProvider)
+	}))
+	mux.Handle("GET /v1/apps/{appID}/runs", handlers.HandleAppRuns(idProvider))
+	mux.Handle("GET /v1/apps/{appID}/secrets", handlers.HandleAppSecretList(idProvider, cfg))
+	mux.Handle("PUT /v1/apps/{appID}/secrets/{name}", handlers.HandleAppSecretUpsert(idProvider, cfg))
+	mux.Handle("DELETE /v1/apps/{appID}/secrets/{name}", handlers.HandleAppSecretDelete(idProvider, cfg))
+	mux.Handle("GET /v1/apps/{appID}/exposed-ports", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleAppPorts(a.BricksIndex, idProvider) }))
 	mux.Handle("PUT /v1/apps/{appID}/sketch/libraries/{libRef}", handlers.HandleSketchAddLibrary(idProvider))
 	mux.Handle("DELETE /v1/apps/{appID}/sketch/libraries/{libRef}", handlers.HandleSketchRemoveLibrary(idProvider))
 	mux.Handle("GET /v1/apps/{appID}/sketch/libraries", handlers.HandleSketchListLibraries(idProvider))
-
-	mux.Handle("GET /v1/apps/{appID}/bricks", handlers.HandleAppBrickInstancesList(brickService, idProvider))
-	mux.Handle("GET /v1/apps/{appID}/bricks/{brickID}", handlers.HandleAppBrickInstanceDetails(brickService, idProvider))
-	mux.Handle("PUT /v1/apps/{appID}/bricks/{brickID}", handlers.HandleBrickCreate(brickService, idProvider))
-	mux.Handle("PATCH /v1/apps/{appID}/bricks/{brickID}", handlers.HandleBrickUpdates(brickService, idProvider))
-	mux.Handle("DELETE /v1/apps/{appID}/bricks/{brickID}", handlers.HandleBrickDelete(brickService, idProvider))
+	mux.Handle("POST /v1/apps/{appID}/sketch/compile", handlers.HandleSketchCompile(idProvider, cfg))
+
+	mux.Handle("GET /v1/apps/{appID}/bricks", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppBrickInstancesList(a.BrickService, idProvider)
+	}))
+	mux.Handle("GET /v1/apps/{appID}/bricks/{brickID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler {
+		return handlers.HandleAppBrickInstanceDetails(a.BrickService, idProvider)
+	}))
+	mux.Handle("PUT /v1/apps/{appID}/bricks/{brickID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleBrickCreate(a.BrickService, idProvider) }))
+	mux.Handle("PATCH /v1/apps/{appID}/bricks/{brickID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleBrickUpdates(a.BrickService, idProvider) }))
+	mux.Handle("DELETE /v1/apps/{appID}/bricks/{brickID}", withAssets(assetsRegistry, func(a *assets.Assets) http.Handler { return handlers.HandleBrickDelete(a.BrickService, idProvider) }))
 
 	mux.Handle("GET /v1/docs/", http.StripPrefix("/v1/docs/", handlers.DocsServer(docsFS)))
 
@@ -101,3 +134,11 @@ func NewHTTPRouter(
 
 	return mux
 }
+
+// withAssets builds the handler with the assets current when the request is received, so
+// that the request is served with the same indexes even if they are reloaded meanwhile.
+func withAssets(registry *assets.Registry, handler func(a *assets.Assets) http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(registry.Get()).ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,238 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/arduino/arduino-app-cli/internal/audit"
+	"github.com/arduino/arduino-app-cli/internal/auth"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+// maxAuditedBody is the size of the request and response bodies inspected by the audit.
+const maxAuditedBody = 64 * 1024
+
+// AuditMiddleware records the requests changing the apps or the system into the audit log.
+// It must wrap the AuthMiddleware, so that the requests rejected by the authentication are
+// recorded too, and the caller identified by the authentication is reported through the
+// context. The brick variables declared as secrets are redacted according to the bricks index.
+func AuditMiddleware(log *audit.Log, bricksIndex func() *bricksindex.BricksIndex) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			default:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			operation, appID := auditOperation(r.Method, r.URL.Path)
+			entry := audit.Entry{
+				Source:    audit.SourceAPI,
+				Operation: operation,
+				AppID:     appID,
+				Params:    auditParams(r, bricksIndex),
+			}
+
+			ctx, result := audit.WithResult(r.Context())
+			rec := &auditRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			entry.Caller = auditCaller(r, result.Caller())
+			entry.Status = rec.status
+			entry.Result = audit.ResultSuccess
+			var body struct {
+				ID      string `json:"id"`
+				Details string `json:"details"`
+			}
+			_ = json.Unmarshal(rec.body.Bytes(), &body)
+			if rec.status >= http.StatusBadRequest {
+				entry.Result = audit.ResultFailure
+				entry.Error = body.Details
+			} else if failure := result.Failure(); failure != "" {
+				// The errors sent on the event streams.
+				entry.Result = audit.ResultFailure
+				entry.Error = failure
+			} else if entry.AppID == "" && strings.HasPrefix(r.URL.Path, "/v1/apps") {
+				// The created, cloned and imported apps.
+				entry.AppID = body.ID
+			}
+			appendAuditEntry(log, entry)
+
+			// The operations going on after the response are recorded again when they end.
+			result.OnDeferred(func(failure string) {
+				entry.Result, entry.Error = audit.ResultSuccess, ""
+				if failure != "" {
+					entry.Result, entry.Error = audit.ResultFailure, failure
+				}
+				appendAuditEntry(log, entry)
+			})
+		})
+	}
+}
+
+func appendAuditEntry(log *audit.Log, entry audit.Entry) {
+	if err := log.Append(entry); err != nil {
+		slog.Error("unable to write the audit log", slog.String("error", err.Error()))
+	}
+}
+
+// auditOperation returns the operation, with the app ID replaced by {appID} so that the
+// operations can be grouped, and the app ID.
+func auditOperation(method, path string) (string, string) {
+	rest, found := strings.CutPrefix(path, "/v1/apps/")
+	if !found {
+		return method + " " + path, ""
+	}
+	appID, rest, _ := strings.Cut(rest, "/")
+	if appID == "import" || appID == "events" {
+		return method + " " + path, ""
+	}
+	operation := method + " /v1/apps/{appID}"
+	if rest != "" {
+		operation += "/" + rest
+	}
+	return operation, appID
+}
+
+// auditCaller returns the caller identified by the authentication, or the origin of the
+// browser when the authentication is disabled.
+func auditCaller(r *http.Request, reported string) string {
+	caller := reported
+	if caller == "" {
+		caller = auth.CallerFromContext(r.Context())
+	}
+	if origin := r.Header.Get("Origin"); caller == auth.CallerLocal && origin != "" {
+		return "origin:" + origin
+	}
+	return caller
+}
+
+// auditParams returns the query parameters and the fields of the JSON body, with the
+// secrets redacted.
+func auditParams(r *http.Request, bricksIndex func() *bricksindex.BricksIndex) map[string]any {
+	params := map[string]any{}
+	for key, values := range r.URL.Query() {
+		if key == "access_token" {
+			continue
+		}
+		if len(values) == 1 {
+			params[key] = values[0]
+		} else {
+			params[key] = values
+		}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" && r.Body != nil && r.ContentLength <= maxAuditedBody {
+		data, err := io.ReadAll(io.LimitReader(r.Body, maxAuditedBody))
+		// Give back to the handler what has been read.
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), r.Body), r.Body}
+		var body map[string]any
+		if err == nil && json.Unmarshal(data, &body) == nil {
+			// Every value of the secrets endpoints is a secret.
+			secrets := strings.Contains(r.URL.Path, "/secrets/")
+			for key, value := range body {
+				if secrets {
+					value = audit.Redacted
+				}
+				params[key] = value
+			}
+			if brickID := auditBrickID(r.URL.Path); brickID != "" {
+				redactSecretVariables(params, brickID, bricksIndex())
+			}
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	return audit.Redact(params)
+}
+
+// auditBrickID returns the brick of the requests configuring a brick of an app.
+func auditBrickID(path string) string {
+	rest, found := strings.CutPrefix(path, "/v1/apps/")
+	if !found {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 || parts[1] != "bricks" {
+		return ""
+	}
+	return parts[2]
+}
+
+// redactSecretVariables redacts the values of the variables that the brick declares as secrets.
+func redactSecretVariables(params map[string]any, brickID string, bricksIndex *bricksindex.BricksIndex) {
+	variables, ok := params["variables"].(map[string]any)
+	if !ok || bricksIndex == nil {
+		return
+	}
+	brick, found := bricksIndex.FindBrickByID(brickID)
+	if !found {
+		return
+	}
+	for name := range variables {
+		if variable, found := brick.GetVariable(name); found && variable.GetType() == bricksindex.VariableTypeSecret {
+			variables[name] = audit.Redacted
+		}
+	}
+}
+
+// auditRecorder keeps the status and the beginning of the body of the response.
+type auditRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *auditRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *auditRecorder) Write(data []byte) (int, error) {
+	rec.wroteHeader = true
+	if free := maxAuditedBody - rec.body.Len(); free > 0 {
+		rec.body.Write(data[:min(free, len(data))])
+	}
+	return rec.ResponseWriter.Write(data)
+}
+
+// Flush is needed by the event streams.
+func (rec *auditRecorder) Flush() {
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (rec *auditRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
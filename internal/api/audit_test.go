@@ -0,0 +1,187 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/audit"
+	"github.com/arduino/arduino-app-cli/internal/auth"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	log := audit.NewLog(paths.New(t.TempDir(), "audit.log"), 1024*1024, 1)
+
+	bricksIndex := &bricksindex.BricksIndex{Bricks: []bricksindex.Brick{{
+		ID: "arduino:db",
+		Variables: []bricksindex.BrickVariable{
+			{Name: "CITY"},
+			{Name: "DB_URL", Type: bricksindex.VariableTypeSecret},
+		},
+	}}}
+
+	var received string
+	var upgradeDone func(failure string)
+	handler := AuditMiddleware(log, func() *bricksindex.BricksIndex { return bricksIndex })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		switch {
+		case r.URL.Path == "/v1/apps":
+			render.EncodeResponse(w, http.StatusCreated, map[string]string{"id": "dXNlcjpuZXc"})
+		case strings.HasSuffix(r.URL.Path, "/stop"):
+			render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: "unable to find the app"})
+		case strings.HasSuffix(r.URL.Path, "/start"):
+			// The errors of the event streams are sent after the status.
+			w.WriteHeader(http.StatusOK)
+			audit.ReportFailure(r.Context(), "cannot start the app")
+		case r.URL.Path == "/v1/system/update/apply":
+			upgradeDone = audit.DeferResult(r.Context())
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	do := func(method, target, body, caller, origin string) {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if caller != "" {
+			req = req.WithContext(auth.WithCaller(req.Context(), caller))
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	do(http.MethodGet, "/v1/apps", "", "", "")
+	do(http.MethodPost, "/v1/apps", `{"name":"new"}`, "token:0123abcd", "")
+	require.Equal(t, `{"name":"new"}`, received, "the body is given back to the handler")
+	do(http.MethodPost, "/v1/apps/dXNlcjp3ZWF0aGVy/stop", "", "", "wails://wails")
+	do(http.MethodPatch, "/v1/apps/dXNlcjp3ZWF0aGVy/bricks/arduino:db?force=true", `{"variables":{"DB_PASSWORD":"hunter2","DB_URL":"influx://admin:s3cret@db","CITY":"Turin"}}`, auth.CallerDesktopClient, "")
+	do(http.MethodPut, "/v1/apps/dXNlcjp3ZWF0aGVy/secrets/influx", `{"value":"hunter2"}`, "", "")
+
+	entries, err := log.List(audit.Query{})
+	require.NoError(t, err)
+	require.Len(t, entries, 4, "the read-only requests are not recorded")
+	for i := range entries {
+		require.False(t, entries[i].Time.IsZero())
+		entries[i].Time = time.Time{}
+	}
+	data, err := json.Marshal(entries)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "hunter2")
+	require.NotContains(t, string(data), "s3cret", "the variables declared as secrets are redacted")
+
+	require.Equal(t, audit.Entry{
+		Source: audit.SourceAPI, Operation: "POST /v1/apps", AppID: "dXNlcjpuZXc",
+		Caller: "token:0123abcd", Params: map[string]any{"name": "new"}, Result: audit.ResultSuccess, Status: http.StatusCreated,
+	}, entries[0])
+	require.Equal(t, audit.Entry{
+		Source: audit.SourceAPI, Operation: "POST /v1/apps/{appID}/stop", AppID: "dXNlcjp3ZWF0aGVy",
+		Caller: "origin:wails://wails", Result: audit.ResultFailure, Status: http.StatusNotFound, Error: "unable to find the app",
+	}, entries[1])
+	require.Equal(t, "PATCH /v1/apps/{appID}/bricks/arduino:db", entries[2].Operation)
+	require.Equal(t, auth.CallerDesktopClient, entries[2].Caller)
+	require.Equal(t, map[string]any{
+		"force":     "true",
+		"variables": map[string]any{"DB_PASSWORD": audit.Redacted, "DB_URL": audit.Redacted, "CITY": "Turin"},
+	}, entries[2].Params)
+	require.Equal(t, map[string]any{"value": audit.Redacted}, entries[3].Params)
+	require.Equal(t, auth.CallerLocal, entries[3].Caller)
+
+	t.Run("results after the status", func(t *testing.T) {
+		do(http.MethodPost, "/v1/apps/dXNlcjp3ZWF0aGVy/start", "", "", "")
+		do(http.MethodPut, "/v1/system/update/apply", "", "", "")
+		require.NotNil(t, upgradeDone)
+		upgradeDone("failed to upgrade APT packages")
+		upgradeDone("")
+
+		entries, err := log.List(audit.Query{})
+		require.NoError(t, err)
+		require.Len(t, entries, 7)
+		start := entries[4]
+		require.Equal(t, "POST /v1/apps/{appID}/start", start.Operation)
+		require.Equal(t, audit.ResultFailure, start.Result)
+		require.Equal(t, http.StatusOK, start.Status)
+		require.Equal(t, "cannot start the app", start.Error)
+
+		// The upgrade is recorded when it is accepted, and again when it ends.
+		require.Equal(t, "PUT /v1/system/update/apply", entries[5].Operation)
+		require.Equal(t, audit.ResultSuccess, entries[5].Result)
+		require.Equal(t, "PUT /v1/system/update/apply", entries[6].Operation)
+		require.Equal(t, audit.ResultFailure, entries[6].Result)
+		require.Equal(t, "failed to upgrade APT packages", entries[6].Error)
+	})
+}
+
+func TestAuditAuthentication(t *testing.T) {
+	log := audit.NewLog(paths.New(t.TempDir(), "audit.log"), 1024*1024, 1)
+	store := auth.NewStore(paths.New(t.TempDir(), "tokens.json"))
+	readToken, readInfo, err := store.Create("dashboard", []auth.Scope{auth.ScopeReadOnly})
+	require.NoError(t, err)
+	appsToken, appsInfo, err := store.Create("ci", []auth.Scope{auth.ScopeApps})
+	require.NoError(t, err)
+	desktop := auth.NewDesktopToken(paths.New(t.TempDir(), "desktop-token"))
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	handler := AuditMiddleware(log, func() *bricksindex.BricksIndex { return &bricksindex.BricksIndex{} })(AuthMiddleware(store, desktop, config.AuthSettings{Enabled: true})(next))
+	do := func(token string) {
+		req := httptest.NewRequest(http.MethodDelete, "/v1/apps/dXNlcjp3ZWF0aGVy", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	do("")
+	do("aac_invalid")
+	do(readToken)
+	do(appsToken)
+
+	entries, err := log.List(audit.Query{})
+	require.NoError(t, err)
+	require.Len(t, entries, 4, "the rejected requests are recorded too")
+	expected := []struct {
+		caller string
+		status int
+		result string
+	}{
+		{auth.CallerUnauthenticated, http.StatusUnauthorized, audit.ResultFailure},
+		{auth.CallerUnauthenticated, http.StatusUnauthorized, audit.ResultFailure},
+		{"token:" + readInfo.ID, http.StatusForbidden, audit.ResultFailure},
+		{"token:" + appsInfo.ID, http.StatusNoContent, audit.ResultSuccess},
+	}
+	for i, e := range expected {
+		require.Equal(t, "DELETE /v1/apps/{appID}", entries[i].Operation)
+		require.Equal(t, e.caller, entries[i].Caller)
+		require.Equal(t, e.status, entries[i].Status)
+		require.Equal(t, e.result, entries[i].Result)
+	}
+	require.Equal(t, "a token is required", entries[0].Error)
+}
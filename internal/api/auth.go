@@ -0,0 +1,105 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/audit"
+	"github.com/arduino/arduino-app-cli/internal/auth"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+// AuthMiddleware requires, when the authentication is enabled, a token granting the scope
+// needed by the request. The token is read from the Authorization header, or from the
+// access_token query parameter for the GET requests, since the browsers cannot set headers
+// on the event streams and on the websockets. When the desktop client is exempt, its desktop
+// token is accepted in place of a token granting the scope.
+func AuthMiddleware(store *auth.Store, desktop *auth.DesktopToken, settings config.AuthSettings) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !settings.Enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+			required, protected := auth.RequiredScope(r.Method, r.URL.Path)
+			if !protected {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			value := bearerToken(r)
+			if settings.DesktopClientExempt && value != "" {
+				ok, err := desktop.Authenticate(value)
+				if err != nil {
+					slog.Error("unable to check the desktop token", slog.String("error", err.Error()))
+					render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to check the token"})
+					return
+				}
+				if ok {
+					serveAs(next, w, r, auth.CallerDesktopClient)
+					return
+				}
+			}
+			if value == "" {
+				audit.ReportCaller(r.Context(), auth.CallerUnauthenticated)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="arduino-app-cli"`)
+				render.EncodeResponse(w, http.StatusUnauthorized, models.ErrorResponse{Details: "a token is required"})
+				return
+			}
+			token, ok, err := store.Authenticate(value)
+			if err != nil {
+				slog.Error("unable to check the token", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to check the token"})
+				return
+			}
+			if !ok {
+				audit.ReportCaller(r.Context(), auth.CallerUnauthenticated)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="arduino-app-cli", error="invalid_token"`)
+				render.EncodeResponse(w, http.StatusUnauthorized, models.ErrorResponse{Details: "invalid token"})
+				return
+			}
+			caller := "token:" + token.ID
+			if !auth.Grants(token.Scopes, required) {
+				audit.ReportCaller(r.Context(), caller)
+				render.EncodeResponse(w, http.StatusForbidden, models.ErrorResponse{Details: "the token does not grant the " + string(required) + " scope"})
+				return
+			}
+			serveAs(next, w, r, caller)
+		})
+	}
+}
+
+// serveAs serves the request on behalf of the caller, that is reported to the audit too,
+// since the audit wraps the authentication to record the rejected requests.
+func serveAs(next http.Handler, w http.ResponseWriter, r *http.Request, caller string) {
+	audit.ReportCaller(r.Context(), caller)
+	next.ServeHTTP(w, r.WithContext(auth.WithCaller(r.Context(), caller)))
+}
+
+func bearerToken(r *http.Request) string {
+	if value, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+		return strings.TrimSpace(value)
+	}
+	if r.Method == http.MethodGet {
+		return r.URL.Query().Get("access_token")
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/auth"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	store := auth.NewStore(paths.New(t.TempDir(), "tokens.json"))
+	readToken, _, err := store.Create("dashboard", []auth.Scope{auth.ScopeReadOnly})
+	require.NoError(t, err)
+	appsToken, appsInfo, err := store.Create("ci", []auth.Scope{auth.ScopeApps})
+	require.NoError(t, err)
+	desktop := auth.NewDesktopToken(paths.New(t.TempDir(), "desktop-token"))
+	desktopToken, err := desktop.Get()
+	require.NoError(t, err)
+
+	var caller string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		caller = auth.CallerFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	do := func(handler http.Handler, method, target, token, origin string) int {
+		caller = ""
+		req := httptest.NewRequest(method, target, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		handler := AuthMiddleware(store, desktop, config.AuthSettings{})(next)
+		require.Equal(t, http.StatusOK, do(handler, http.MethodDelete, "/v1/apps/abc", "", ""))
+		require.Equal(t, auth.CallerLocal, caller)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		handler := AuthMiddleware(store, desktop, config.AuthSettings{Enabled: true})(next)
+		require.Equal(t, http.StatusOK, do(handler, http.MethodGet, "/v1/version", "", ""))
+		require.Equal(t, http.StatusUnauthorized, do(handler, http.MethodGet, "/v1/apps", "", ""))
+		require.Equal(t, http.StatusUnauthorized, do(handler, http.MethodGet, "/v1/apps", "aac_invalid", ""))
+		require.Equal(t, http.StatusOK, do(handler, http.MethodGet, "/v1/apps", readToken, ""))
+		require.Equal(t, http.StatusForbidden, do(handler, http.MethodDelete, "/v1/apps/abc", readToken, ""))
+		require.Equal(t, http.StatusOK, do(handler, http.MethodDelete, "/v1/apps/abc", appsToken, ""))
+		require.Equal(t, "token:"+appsInfo.ID, caller)
+		require.Equal(t, http.StatusForbidden, do(handler, http.MethodPut, "/v1/system/update/apply", appsToken, ""))
+		require.Equal(t, http.StatusOK, do(handler, http.MethodGet, "/v1/apps/events?access_token="+readToken, "", ""))
+		// The desktop client is not exempt.
+		require.Equal(t, http.StatusUnauthorized, do(handler, http.MethodGet, "/v1/apps", desktopToken, ""))
+		require.Equal(t, http.StatusUnauthorized, do(handler, http.MethodGet, "/v1/apps", "", "wails://wails"))
+	})
+
+	t.Run("desktop client exempt", func(t *testing.T) {
+		handler := AuthMiddleware(store, desktop, config.AuthSettings{Enabled: true, DesktopClientExempt: true})(next)
+		require.Equal(t, http.StatusOK, do(handler, http.MethodDelete, "/v1/apps/abc", desktopToken, ""))
+		require.Equal(t, auth.CallerDesktopClient, caller)
+		require.Equal(t, http.StatusOK, do(handler, http.MethodPut, "/v1/system/update/apply", desktopToken, ""))
+		require.Equal(t, http.StatusUnauthorized, do(handler, http.MethodGet, "/v1/apps", "aacd_invalid", ""))
+		// The origin of the webview can be forged, it does not identify the desktop client.
+		require.Equal(t, http.StatusUnauthorized, do(handler, http.MethodGet, "/v1/apps", "", "wails://wails"))
+		// The other tokens are still accepted.
+		require.Equal(t, http.StatusOK, do(handler, http.MethodDelete, "/v1/apps/abc", appsToken, ""))
+		require.Equal(t, "token:"+appsInfo.ID, caller)
+	})
+}
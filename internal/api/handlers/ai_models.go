@@ -16,14 +16,21 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 
+	"github.com/docker/cli/cli/command"
+
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 	"github.com/arduino/arduino-app-cli/internal/render"
+	"github.com/arduino/arduino-app-cli/internal/store"
 )
 
 func HandleModelsList(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
@@ -41,14 +48,14 @@ func HandleModelsList(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
 	}
 }
 
-func HandlerModelByID(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
+func HandlerModelByID(modelsIndex *modelsindex.ModelsIndex, bricksIndex *bricksindex.BricksIndex) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id := r.PathValue("modelID")
 		if id == "" {
 			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "id must be set"})
 			return
 		}
-		res, found := orchestrator.AIModelDetails(modelsIndex, id)
+		res, found := orchestrator.AIModelDetails(modelsIndex, bricksIndex, id)
 		if !found {
 			details := fmt.Sprintf("models with id %q not found", id)
 			render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: details})
@@ -57,3 +64,71 @@ func HandlerModelByID(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
 		render.EncodeResponse(w, http.StatusOK, res)
 	}
 }
+
+func HandleModelAdd(
+	dockerClient command.Cli,
+	modelsIndex *modelsindex.ModelsIndex,
+	bricksIndex *bricksindex.BricksIndex,
+	staticStore *store.StaticStore,
+	cfg config.Configuration,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, orchestrator.MaxAIModelSize)
+		defer r.Body.Close()
+
+		params := r.URL.Query()
+		req := orchestrator.AddAIModelRequest{
+			ID:          params.Get("id"),
+			Name:        params.Get("name"),
+			Description: params.Get("description"),
+		}
+		if bricks := params.Get("bricks"); bricks != "" {
+			req.Bricks = strings.Split(strings.TrimSpace(bricks), ",")
+		}
+		if labels := params.Get("labels"); labels != "" {
+			req.Labels = strings.Split(strings.TrimSpace(labels), ",")
+		}
+
+		res, err := orchestrator.AddAIModel(r.Context(), dockerClient.Client(), cfg, modelsIndex, bricksIndex, staticStore, req, r.Body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			switch {
+			case errors.As(err, &maxBytesErr) || errors.Is(err, orchestrator.ErrAIModelTooLarge):
+				render.EncodeResponse(w, http.StatusRequestEntityTooLarge, models.ErrorResponse{Details: "model too large"})
+			case errors.Is(err, modelsindex.ErrModelAlreadyExists):
+				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: err.Error()})
+			case errors.Is(err, orchestrator.ErrInvalidModel):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("unable to add the model", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to add the model"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusCreated, res)
+	}
+}
+
+func HandleModelDelete(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("modelID")
+		if id == "" {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "id must be set"})
+			return
+		}
+		if err := orchestrator.RemoveAIModel(modelsIndex, id); err != nil {
+			switch {
+			case errors.Is(err, orchestrator.ErrModelNotFound):
+				details := fmt.Sprintf("models with id %q not found", id)
+				render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: details})
+			case errors.Is(err, orchestrator.ErrModelNotRemovable):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("unable to remove the model", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to remove the model"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, nil)
+	}
+}
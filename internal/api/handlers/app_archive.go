@@ -0,0 +1,147 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/gosimple/slug"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+func HandleAppExport(
+	bricksIndex *bricksindex.BricksIndex,
+	idProvider *app.IDProvider,
+	cfg config.Configuration,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+
+		var includeData bool
+		if v := r.URL.Query().Get("include_data"); v != "" {
+			includeData, err = strconv.ParseBool(v)
+			if err != nil {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid include_data value"})
+				return
+			}
+		}
+
+		app, err := app.Load(id.ToPath().String())
+		if err != nil {
+			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+
+		// The archive is spooled to a file, the export can still fail after writing most of it.
+		archive, err := paths.MkTempFile(nil, "app-export-")
+		if err != nil {
+			slog.Error("Unable to create the app archive", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to export the app"})
+			return
+		}
+		defer func() {
+			_ = archive.Close()
+			_ = os.Remove(archive.Name())
+		}()
+		if err := orchestrator.ExportApp(r.Context(), archive, app, orchestrator.ExportAppRequest{IncludeData: includeData}, bricksIndex, cfg); err != nil {
+			if errors.Is(err, orchestrator.ErrAppArchiveTooLarge) {
+				slog.Error("app archive too large", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusRequestEntityTooLarge, models.ErrorResponse{Details: "app archive too large"})
+				return
+			}
+			slog.Error("Unable to export the app", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to export the app"})
+			return
+		}
+		size, err := archive.Seek(0, io.SeekCurrent)
+		if err == nil {
+			_, err = archive.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			slog.Error("Unable to read the app archive", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to export the app"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", slug.Make(app.Name)+".tar.gz"))
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, archive); err != nil {
+			slog.Error("Unable to send the app archive", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func HandleAppImport(
+	bricksIndex *bricksindex.BricksIndex,
+	modelsIndex *modelsindex.ModelsIndex,
+	idProvider *app.IDProvider,
+	cfg config.Configuration,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, orchestrator.MaxAppArchiveSize)
+		defer r.Body.Close()
+
+		var req orchestrator.ImportAppRequest
+		if name := r.URL.Query().Get("name"); name != "" {
+			req.Name = &name
+		}
+
+		res, err := orchestrator.ImportApp(r.Context(), r.Body, req, idProvider, bricksIndex, modelsIndex, cfg)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) || errors.Is(err, orchestrator.ErrAppArchiveTooLarge) {
+				slog.Error("app archive too large", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusRequestEntityTooLarge, models.ErrorResponse{Details: "app archive too large"})
+				return
+			}
+			if errors.Is(err, orchestrator.ErrAppAlreadyExists) {
+				slog.Error("app already exists", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: "app already exists"})
+				return
+			}
+			if errors.Is(err, orchestrator.ErrInvalidAppArchive) {
+				slog.Error("invalid app archive", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+				return
+			}
+			slog.Error("unable to import app", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to import app"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusCreated, res)
+	}
+}
@@ -22,10 +22,11 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
-func HandleAppDelete(idProvider *app.IDProvider) http.HandlerFunc {
+func HandleAppDelete(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
 		if err != nil {
@@ -44,7 +45,7 @@ func HandleAppDelete(idProvider *app.IDProvider) http.HandlerFunc {
 			return
 		}
 
-		err = orchestrator.DeleteApp(r.Context(), app)
+		err = orchestrator.DeleteApp(r.Context(), app, cfg)
 		if err != nil {
 			slog.Error("Unable to delete the app", slog.String("error", err.Error()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to delete the app"})
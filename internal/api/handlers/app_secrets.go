@@ -0,0 +1,117 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/secrets"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+// HandleAppSecretList returns the names of the secrets of the app, values are never returned.
+func HandleAppSecretList(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		app, ok := loadSecretsApp(w, r, idProvider)
+		if !ok {
+			return
+		}
+
+		names, err := secrets.NewStore(cfg.DataDir()).Names(app.FullPath.String())
+		if err != nil {
+			slog.Error("Unable to list app secrets", slog.String("error", err.Error()), slog.String("path", app.FullPath.String()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to list the secrets"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, models.AppSecretNamesResponse{Names: names})
+	}
+}
+
+func HandleAppSecretUpsert(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		app, ok := loadSecretsApp(w, r, idProvider)
+		if !ok {
+			return
+		}
+
+		defer r.Body.Close()
+		var req models.AppSecretUpsertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			slog.Warn("Failed to decode request body", "error", err.Error())
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid body"})
+			return
+		}
+
+		name := r.PathValue("name")
+		if err := secrets.NewStore(cfg.DataDir()).Set(app.FullPath.String(), name, req.Value); err != nil {
+			if errors.Is(err, secrets.ErrInvalidName) {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+				return
+			}
+			slog.Error("Failed to set app secret", slog.String("name", name), slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "failed to set the secret"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusNoContent, nil)
+	}
+}
+
+func HandleAppSecretDelete(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		app, ok := loadSecretsApp(w, r, idProvider)
+		if !ok {
+			return
+		}
+
+		name := r.PathValue("name")
+		found, err := secrets.NewStore(cfg.DataDir()).Delete(app.FullPath.String(), name)
+		if err != nil {
+			if errors.Is(err, secrets.ErrInvalidName) {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+				return
+			}
+			slog.Error("Failed to delete app secret", slog.String("name", name), slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "failed to delete the secret"})
+			return
+		}
+		if !found {
+			render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: "secret not found"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusNoContent, nil)
+	}
+}
+
+func loadSecretsApp(w http.ResponseWriter, r *http.Request, idProvider *app.IDProvider) (app.ArduinoApp, bool) {
+	id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+	if err != nil {
+		render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+		return app.ArduinoApp{}, false
+	}
+	a, err := app.Load(id.ToPath().String())
+	if err != nil {
+		slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
+		render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: "unable to find the app"})
+		return app.ArduinoApp{}, false
+	}
+	return a, true
+}
@@ -16,12 +16,15 @@
 package handlers
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/docker/cli/cli/command"
 
 	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/audit"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
@@ -47,6 +50,15 @@ func HandleAppStart(
 			return
 		}
 
+		var force bool
+		if v := r.URL.Query().Get("force"); v != "" {
+			force, err = strconv.ParseBool(v)
+			if err != nil {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid force value"})
+				return
+			}
+		}
+
 		app, err := app.Load(id.ToPath().String())
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
@@ -69,13 +81,25 @@ func HandleAppStart(
 		type log struct {
 			Message string `json:"message"`
 		}
-		for item := range orchestrator.StartApp(r.Context(), dockerCli, provisioner, modelsIndex, bricksIndex, app, cfg, staticStore) {
+		for item := range orchestrator.StartApp(r.Context(), dockerCli, provisioner, modelsIndex, bricksIndex, app, cfg, staticStore, force) {
 			switch item.GetType() {
 			case orchestrator.ProgressType:
 				sseStream.Send(render.SSEEvent{Type: "progress", Data: progress(*item.GetProgress())})
 			case orchestrator.InfoType:
 				sseStream.Send(render.SSEEvent{Type: "message", Data: log{Message: item.GetData()}})
+			case orchestrator.DiagnosticType:
+				sseStream.Send(render.SSEEvent{Type: "diagnostic", Data: item.GetDiagnostic()})
 			case orchestrator.ErrorType:
+				audit.ReportFailure(r.Context(), item.GetError().Error())
+				var flashOwned *orchestrator.FlashOwnedError
+				if errors.As(item.GetError(), &flashOwned) {
+					sseStream.SendError(render.SSEErrorData{
+						Code:    render.FlashOwnedErr,
+						Message: item.GetError().Error(),
+						Owner:   flashOwned.Owner,
+					})
+					continue
+				}
 				sseStream.SendError(render.SSEErrorData{
 					Code:    render.InternalServiceErr,
 					Message: item.GetError().Error(),
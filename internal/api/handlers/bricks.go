@@ -26,7 +26,9 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
@@ -145,6 +147,19 @@ func HandleBrickCreate(
 
 		err = brickService.BrickCreate(req, app)
 		if err != nil {
+			var invalidVariables *bricks.InvalidVariablesError
+			if errors.As(err, &invalidVariables) {
+				render.EncodeResponse(w, http.StatusBadRequest, invalidVariablesResponse(invalidVariables))
+				return
+			}
+			if errors.Is(err, bricksindex.ErrBrickConflict) {
+				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: err.Error()})
+				return
+			}
+			if errors.Is(err, modelsindex.ErrIncompatibleModel) {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+				return
+			}
 			// TODO: handle specific errors
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "error while creating or updating brick"})
@@ -213,6 +228,15 @@ func HandleBrickUpdates(
 		req.ID = id
 		err = brickService.BrickUpdate(req, app)
 		if err != nil {
+			var invalidVariables *bricks.InvalidVariablesError
+			if errors.As(err, &invalidVariables) {
+				render.EncodeResponse(w, http.StatusBadRequest, invalidVariablesResponse(invalidVariables))
+				return
+			}
+			if errors.Is(err, modelsindex.ErrIncompatibleModel) {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+				return
+			}
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to update the brick"})
 
@@ -270,3 +294,11 @@ func HandleBrickDelete(
 		render.EncodeResponse(w, http.StatusOK, nil)
 	}
 }
+
+func invalidVariablesResponse(err *bricks.InvalidVariablesError) models.ErrorResponse {
+	res := models.ErrorResponse{Details: "invalid brick variables"}
+	for _, e := range err.Errors {
+		res.Errors = append(res.Errors, models.FieldError{Field: "variables." + e.Name, Message: e.Message})
+	}
+	return res
+}
@@ -23,11 +23,11 @@ import (
 	"net"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/gorilla/websocket"
 
 	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/monitor"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
@@ -140,7 +140,7 @@ func HandleMonitorWS(allowedOrigins []string) http.HandlerFunc {
 
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Connect to monitor
-		mon, err := net.DialTimeout("tcp", "127.0.0.1:7500", time.Second)
+		mon, err := monitor.Dial()
 		if err != nil {
 			slog.Error("Unable to connect to monitor", slog.String("error", err.Error()))
 			render.EncodeResponse(w, http.StatusServiceUnavailable, models.ErrorResponse{Details: "Unable to connect to monitor: " + err.Error()})
@@ -0,0 +1,105 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+func HandleAssetsReload(registry *assets.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := registry.Reload()
+		if err != nil {
+			slog.Error("unable to reload the assets", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to reload the assets"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, models.AssetsReloadResponse{
+			Path:     res.Dir.String(),
+			Bricks:   len(res.BricksIndex.Bricks),
+			Models:   len(res.ModelsIndex.GetModels()),
+			LoadedAt: res.LoadedAt,
+		})
+	}
+}
+
+func HandleAssetsList(cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		versions, err := assets.ListVersions(cfg)
+		if err != nil {
+			slog.Error("unable to list the assets", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to list the assets"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, models.AssetsVersionsResponse{Versions: versions})
+	}
+}
+
+func HandleAssetsPin(cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		var req models.AssetsPinRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid request body"})
+			return
+		}
+		if err := assets.PinVersion(cfg, req.Version); err != nil {
+			switch {
+			case errors.Is(err, assets.ErrInvalidVersion):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			case errors.Is(err, assets.ErrVersionNotFound):
+				render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("unable to pin the assets", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to pin the assets"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusNoContent, nil)
+	}
+}
+
+func HandleAssetsUnpin(cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := assets.UnpinVersion(cfg); err != nil {
+			slog.Error("unable to unpin the assets", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to unpin the assets"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusNoContent, nil)
+	}
+}
+
+func HandleAssetsPrune(cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := assets.PruneVersions(cfg)
+		if err != nil {
+			slog.Error("unable to prune the assets", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to prune the assets"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, res)
+	}
+}
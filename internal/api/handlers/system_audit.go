@@ -0,0 +1,68 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/audit"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+func HandleSystemAudit(log *audit.Log) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		query := audit.Query{
+			AppID:     params.Get("app_id"),
+			Caller:    params.Get("caller"),
+			Operation: params.Get("operation"),
+			Limit:     defaultAuditLimit,
+		}
+		if v := params.Get("since"); v != "" {
+			since, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid since, use the RFC 3339 format"})
+				return
+			}
+			query.Since = since
+		}
+		if v := params.Get("limit"); v != "" {
+			limit, err := strconv.Atoi(v)
+			if err != nil || limit < 1 || limit > maxAuditLimit {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid limit, use a number from 1 to 1000"})
+				return
+			}
+			query.Limit = limit
+		}
+
+		entries, err := log.List(query)
+		if err != nil {
+			slog.Error("unable to read the audit log", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to read the audit log"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, models.AuditResponse{Entries: entries})
+	}
+}
@@ -18,13 +18,15 @@ package handlers
 import (
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
-func HandleSystemResources() http.HandlerFunc {
+func HandleSystemResources(cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		sseStream, err := render.NewSSEStream(ctx, w)
@@ -35,7 +37,11 @@ func HandleSystemResources() http.HandlerFunc {
 		}
 		defer sseStream.Close()
 
-		resources, err := orchestrator.SystemResources(ctx, nil)
+		resources, err := orchestrator.SystemResources(ctx, &orchestrator.SystemResourceConfig{
+			CPUScrapeInterval:    time.Duration(cfg.Resources.CPUScrapeInterval),
+			MemoryScrapeInterval: time.Duration(cfg.Resources.MemoryScrapeInterval),
+			DiskScrapeInterval:   time.Duration(cfg.Resources.DiskScrapeInterval),
+		})
 		if err != nil {
 			sseStream.SendError(render.SSEErrorData{
 				Code:    render.InternalServiceErr,
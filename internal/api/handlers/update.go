@@ -23,6 +23,7 @@ import (
 	"log/slog"
 
 	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/audit"
 	"github.com/arduino/arduino-app-cli/internal/render"
 	"github.com/arduino/arduino-app-cli/internal/update"
 )
@@ -93,8 +94,11 @@ func HandleUpdateApply(updater *update.Manager) http.HandlerFunc {
 			return
 		}
 
+		// Subscribe before starting, so that the result of the upgrade is not missed.
+		events := updater.Subscribe()
 		err = updater.UpgradePackages(r.Context(), pkgs)
 		if err != nil {
+			updater.Unsubscribe(events)
 			if errors.Is(err, update.ErrOperationAlreadyInProgress) {
 				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: err.Error()})
 				return
@@ -103,10 +107,27 @@ func HandleUpdateApply(updater *update.Manager) http.HandlerFunc {
 			return
 		}
 
+		go auditUpgradeResult(updater, events, audit.DeferResult(r.Context()))
+
 		render.EncodeResponse(w, http.StatusAccepted, "Upgrade started")
 	}
 }
 
+// auditUpgradeResult records the result of the upgrade running in background.
+func auditUpgradeResult(updater *update.Manager, events chan update.Event, done func(failure string)) {
+	defer updater.Unsubscribe(events)
+	for event := range events {
+		switch event.Type {
+		case update.ErrorEvent:
+			done(event.Data)
+			return
+		case update.DoneEvent:
+			done("")
+			return
+		}
+	}
+}
+
 func HandleUpdateEvents(updater *update.Manager) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		sseStream, err := render.NewSSEStream(r.Context(), w)
@@ -0,0 +1,37 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package models
+
+import (
+	"time"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
+)
+
+type AssetsReloadResponse struct {
+	Path     string    `json:"path"`
+	Bricks   int       `json:"bricks"`
+	Models   int       `json:"models"`
+	LoadedAt time.Time `json:"loaded_at"`
+}
+
+type AssetsVersionsResponse struct {
+	Versions []assets.Version `json:"versions"`
+}
+
+type AssetsPinRequest struct {
+	Version string `json:"version"`
+}
@@ -17,4 +17,11 @@ package models
 
 type ErrorResponse struct {
 	Details string `json:"details"`
+	// Errors reports the invalid fields of the request, if any.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
@@ -0,0 +1,245 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package audit records the operations changing the apps and the system, performed
+// through the HTTP API or the CLI, into an append-only log.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/filestore"
+)
+
+// Sources of the operations.
+const (
+	SourceAPI = "api"
+	SourceCLI = "cli"
+)
+
+// Results of the operations.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Entry is an operation recorded in the log.
+type Entry struct {
+	Time   time.Time `json:"time"`
+	Source string    `json:"source"`
+	// Operation is the request, like "POST /v1/apps/{appID}/start", or the command, like "app start".
+	Operation string `json:"operation"`
+	AppID     string `json:"app_id,omitempty"`
+	// Caller is "token:<id>", "desktop-client", "origin:<origin>" or "local" for the API,
+	// "user:<name>" for the CLI.
+	Caller string         `json:"caller"`
+	Params map[string]any `json:"params,omitempty"`
+	Result string         `json:"result"`
+	Status int            `json:"status,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// Query filters the entries of the log, the empty fields match every entry.
+type Query struct {
+	AppID     string
+	Caller    string
+	Operation string
+	Since     time.Time
+	// Limit keeps only the most recent entries.
+	Limit int
+}
+
+func (q Query) match(e Entry) bool {
+	return (q.AppID == "" || e.AppID == q.AppID) &&
+		(q.Caller == "" || e.Caller == q.Caller) &&
+		(q.Operation == "" || strings.Contains(e.Operation, q.Operation)) &&
+		(q.Since.IsZero() || !e.Time.Before(q.Since))
+}
+
+// Log is a JSON lines file, rotated when it grows over maxSize. Up to maxFiles files are kept.
+// The file is written by both the daemon and the CLI, the processes are serialized by a file
+// lock while the goroutines of a process are serialized by a mutex.
+type Log struct {
+	file     *paths.Path
+	maxSize  int64
+	maxFiles int
+	mx       sync.Mutex
+}
+
+func NewLog(file *paths.Path, maxSize int64, maxFiles int) *Log {
+	return &Log{file: file, maxSize: maxSize, maxFiles: max(maxFiles, 1)}
+}
+
+// Append writes an entry at the end of the log.
+func (l *Log) Append(e Entry) error {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Time = e.Time.UTC()
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if err := l.file.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	unlock, err := filestore.WriteLock(l.file.String())
+	if err != nil {
+		return err
+	}
+	defer filestore.Release(l.file.String(), unlock)
+
+	if info, err := l.file.Stat(); err == nil && info.Size() > 0 && info.Size()+int64(len(line)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return fmt.Errorf("rotating the audit log: %w", err)
+		}
+	}
+	f, err := os.OpenFile(l.file.String(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// rotate shifts audit.log.N to audit.log.N+1, dropping the oldest one, and audit.log to audit.log.1.
+func (l *Log) rotate() error {
+	for i := l.maxFiles - 1; i >= 1; i-- {
+		from := l.rotated(i - 1)
+		if from.NotExist() {
+			continue
+		}
+		if err := from.Rename(l.rotated(i)); err != nil {
+			return err
+		}
+	}
+	if l.maxFiles == 1 {
+		return l.file.Remove()
+	}
+	return nil
+}
+
+func (l *Log) rotated(n int) *paths.Path {
+	if n == 0 {
+		return l.file
+	}
+	return paths.New(fmt.Sprintf("%s.%d", l.file, n))
+}
+
+// List returns the entries matching the query, from the oldest to the newest.
+func (l *Log) List(q Query) ([]Entry, error) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	entries := []Entry{}
+	if l.file.Parent().NotExist() {
+		return entries, nil
+	}
+	unlock, err := filestore.ReadLock(l.file.String())
+	if err != nil {
+		return nil, err
+	}
+	defer filestore.Release(l.file.String(), unlock)
+
+	for i := l.maxFiles - 1; i >= 0; i-- {
+		f, err := os.Open(l.rotated(i).String())
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, 1024*1024)
+		for scanner.Scan() {
+			var e Entry
+			if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				// Skip the lines truncated by a crash.
+				continue
+			}
+			if q.match(e) {
+				entries = append(entries, e)
+			}
+		}
+		err = scanner.Err()
+		_ = f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if q.Limit > 0 && len(entries) > q.Limit {
+		entries = entries[len(entries)-q.Limit:]
+	}
+	return entries, nil
+}
+
+// Redacted replaces the value of the secrets.
+const Redacted = "[REDACTED]"
+
+var secretKeys = []string{"password", "passwd", "secret", "token", "apikey", "api_key", "credential", "private_key"}
+
+// IsSecret reports whether a parameter, given its name, holds a secret.
+func IsSecret(name string) bool {
+	name = strings.ToLower(name)
+	return slices.ContainsFunc(secretKeys, func(key string) bool { return strings.Contains(name, key) })
+}
+
+// Redact returns a copy of the parameters, with the values of the secrets replaced, also
+// in the nested objects.
+func Redact(params map[string]any) map[string]any {
+	if params == nil {
+		return nil
+	}
+	res := make(map[string]any, len(params))
+	for k, v := range params {
+		if IsSecret(k) {
+			res[k] = Redacted
+			continue
+		}
+		res[k] = redactValue(v)
+	}
+	return res
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return Redact(v)
+	case []any:
+		res := make([]any, len(v))
+		for i, item := range v {
+			res[i] = redactValue(item)
+		}
+		return res
+	}
+	return v
+}
@@ -0,0 +1,166 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package audit
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog(t *testing.T) {
+	file := paths.New(t.TempDir()).Join("audit", "audit.log")
+	log := NewLog(file, 1024, 3)
+
+	entries, err := log.List(Query{})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	start := time.Now()
+	for i := range 30 {
+		require.NoError(t, log.Append(Entry{
+			Source:    SourceAPI,
+			Operation: "POST /v1/apps/{appID}/start",
+			AppID:     fmt.Sprintf("app%d", i%3),
+			Caller:    "token:0123abcd",
+			Params:    map[string]any{"n": i},
+			Result:    ResultSuccess,
+			Status:    200,
+		}))
+	}
+	require.FileExists(t, file.String())
+	require.FileExists(t, file.String()+".1")
+	require.FileExists(t, file.String()+".2")
+	require.NoFileExists(t, file.String()+".3")
+	info, err := file.Stat()
+	require.NoError(t, err)
+	require.Equal(t, "-rw-------", info.Mode().Perm().String())
+
+	t.Run("query", func(t *testing.T) {
+		all, err := log.List(Query{})
+		require.NoError(t, err)
+		require.Less(t, len(all), 30, "the oldest entries are rotated away")
+		require.Equal(t, float64(29), all[len(all)-1].Params["n"])
+		require.True(t, all[0].Time.Before(all[len(all)-1].Time) || all[0].Time.Equal(all[len(all)-1].Time))
+
+		byApp, err := log.List(Query{AppID: "app1", Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, byApp, 2)
+		require.Equal(t, float64(25), byApp[0].Params["n"])
+		require.Equal(t, float64(28), byApp[1].Params["n"])
+
+		none, err := log.List(Query{Caller: "user:arduino"})
+		require.NoError(t, err)
+		require.Empty(t, none)
+
+		none, err = log.List(Query{Since: start.Add(time.Hour)})
+		require.NoError(t, err)
+		require.Empty(t, none)
+
+		started, err := log.List(Query{Operation: "/start", Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, started, 1)
+	})
+
+	t.Run("truncated line", func(t *testing.T) {
+		file := paths.New(t.TempDir()).Join("audit.log")
+		require.NoError(t, file.WriteFile([]byte("{\"operation\":\"app start\"}\n{\"operat")))
+		entries, err := NewLog(file, 1024, 1).List(Query{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+}
+
+func TestLogSharedByProcesses(t *testing.T) {
+	file := paths.New(t.TempDir()).Join("audit.log")
+	// Each process has its own Log, only the file lock serializes them.
+	daemon, cli := NewLog(file, 1024, 100), NewLog(file, 1024, 100)
+
+	var wg sync.WaitGroup
+	for _, log := range []*Log{daemon, cli} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 50 {
+				assert.NoError(t, log.Append(Entry{Source: SourceCLI, Operation: "app start", Params: map[string]any{"n": i}, Result: ResultSuccess}))
+			}
+		}()
+	}
+	wg.Wait()
+
+	entries, err := daemon.List(Query{})
+	require.NoError(t, err)
+	require.Len(t, entries, 100, "no entry is lost by the rotations")
+}
+
+func TestRedact(t *testing.T) {
+	params := map[string]any{
+		"name":  "weather",
+		"token": "aac_secret",
+		"variables": map[string]any{
+			"DB_PASSWORD": "hunter2",
+			"CITY":        "Turin",
+		},
+		"bricks": []any{map[string]any{"API_KEY": "k"}},
+	}
+	require.Equal(t, map[string]any{
+		"name":  "weather",
+		"token": Redacted,
+		"variables": map[string]any{
+			"DB_PASSWORD": Redacted,
+			"CITY":        "Turin",
+		},
+		"bricks": []any{map[string]any{"API_KEY": Redacted}},
+	}, Redact(params))
+	require.Equal(t, "hunter2", params["variables"].(map[string]any)["DB_PASSWORD"], "the parameters are not modified")
+	require.Nil(t, Redact(nil))
+}
+
+func TestResult(t *testing.T) {
+	// Nothing is reported out of the audited requests.
+	ReportFailure(t.Context(), "ignored")
+	DeferResult(t.Context())("ignored")
+
+	ctx, res := WithResult(t.Context())
+	ReportFailure(ctx, "first")
+	ReportFailure(ctx, "second")
+	require.Equal(t, "first", res.Failure())
+	res.OnDeferred(func(string) { t.Fatal("the operation is not deferred") })
+
+	t.Run("ended before the response", func(t *testing.T) {
+		ctx, res := WithResult(t.Context())
+		DeferResult(ctx)("failed")
+		var recorded []string
+		res.OnDeferred(func(failure string) { recorded = append(recorded, failure) })
+		require.Equal(t, []string{"failed"}, recorded)
+	})
+
+	t.Run("ended after the response", func(t *testing.T) {
+		ctx, res := WithResult(t.Context())
+		done := DeferResult(ctx)
+		var recorded []string
+		res.OnDeferred(func(failure string) { recorded = append(recorded, failure) })
+		require.Empty(t, recorded)
+		done("")
+		done("failed")
+		require.Equal(t, []string{""}, recorded, "only the first result is recorded")
+	})
+}
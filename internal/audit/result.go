@@ -0,0 +1,126 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package audit
+
+import (
+	"context"
+	"sync"
+)
+
+type resultKey struct{}
+
+// Result collects what the audit cannot read from the request and from its response: the
+// caller identified by the authentication, the errors sent on an event stream after the 200
+// status, and the result of the operations going on after the response.
+type Result struct {
+	mu       sync.Mutex
+	caller   string
+	failure  string
+	deferred bool
+	done     bool
+	final    string
+	record   func(failure string)
+}
+
+// WithResult returns a context carrying a new Result, to which the handlers report.
+func WithResult(ctx context.Context) (context.Context, *Result) {
+	res := &Result{}
+	return context.WithValue(ctx, resultKey{}, res), res
+}
+
+// ReportCaller tells who performs the audited request, once identified by the authentication.
+// It does nothing if the request is not audited.
+func ReportCaller(ctx context.Context, caller string) {
+	res, ok := ctx.Value(resultKey{}).(*Result)
+	if !ok {
+		return
+	}
+	res.mu.Lock()
+	defer res.mu.Unlock()
+	res.caller = caller
+}
+
+// ReportFailure marks the audited request as failed, even if its response was successful.
+// Only the first failure is kept. It does nothing if the request is not audited.
+func ReportFailure(ctx context.Context, failure string) {
+	res, ok := ctx.Value(resultKey{}).(*Result)
+	if !ok {
+		return
+	}
+	res.mu.Lock()
+	defer res.mu.Unlock()
+	if res.failure == "" {
+		res.failure = failure
+	}
+}
+
+// DeferResult tells that the operation goes on after the response. The returned function
+// must be called with the failure, or an empty string, when the operation ends: its result
+// is recorded then. It does nothing if the request is not audited.
+func DeferResult(ctx context.Context) func(failure string) {
+	res, ok := ctx.Value(resultKey{}).(*Result)
+	if !ok {
+		return func(string) {}
+	}
+	res.mu.Lock()
+	res.deferred = true
+	res.mu.Unlock()
+	return func(failure string) {
+		res.mu.Lock()
+		if res.done {
+			res.mu.Unlock()
+			return
+		}
+		res.done, res.final = true, failure
+		record := res.record
+		res.mu.Unlock()
+		if record != nil {
+			record(failure)
+		}
+	}
+}
+
+// Caller returns the caller reported by the authentication, empty if not reported.
+func (r *Result) Caller() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.caller
+}
+
+// Failure returns the failure reported while serving the request.
+func (r *Result) Failure() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failure
+}
+
+// OnDeferred calls record with the result of the deferred operation, as soon as it ends.
+// It does nothing if the operation has not been deferred.
+func (r *Result) OnDeferred(record func(failure string)) {
+	r.mu.Lock()
+	if !r.deferred {
+		r.mu.Unlock()
+		return
+	}
+	if !r.done {
+		r.record = record
+		r.mu.Unlock()
+		return
+	}
+	final := r.final
+	r.mu.Unlock()
+	record(final)
+}
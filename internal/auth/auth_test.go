@@ -0,0 +1,135 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package auth
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	file := paths.New(t.TempDir(), "auth", "tokens.json")
+	store := NewStore(file)
+
+	_, _, err := store.Create("ci", nil)
+	require.Error(t, err)
+	_, _, err = store.Create("ci", []Scope{"admin"})
+	require.Error(t, err)
+
+	value, token, err := store.Create("ci", []Scope{ScopeApps, ScopeReadOnly, ScopeApps})
+	require.NoError(t, err)
+	require.Equal(t, []Scope{ScopeApps, ScopeReadOnly}, token.Scopes)
+
+	// Only the hash of the token is stored.
+	content, err := file.ReadFile()
+	require.NoError(t, err)
+	require.NotContains(t, string(content), value)
+	info, err := file.Stat()
+	require.NoError(t, err)
+	require.Equal(t, "-rw-------", info.Mode().Perm().String())
+
+	// A second store, like the one of the daemon, sees the token.
+	other := NewStore(file)
+	found, ok, err := other.Authenticate(value)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, token.ID, found.ID)
+
+	_, ok, err = other.Authenticate(value + "x")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.ErrorIs(t, store.Revoke("unknown"), ErrTokenNotFound)
+	require.NoError(t, store.Revoke(token.ID))
+	_, ok, err = other.Authenticate(value)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	tokens, err := other.List()
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
+
+func TestDesktopToken(t *testing.T) {
+	file := paths.New(t.TempDir(), "auth", "desktop-token")
+	desktop := NewDesktopToken(file)
+
+	ok, err := desktop.Authenticate("aacd_")
+	require.NoError(t, err)
+	require.False(t, ok, "no token is accepted before it is issued")
+
+	value, err := desktop.Get()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(value, desktopTokenPrefix))
+	info, err := file.Stat()
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	again, err := desktop.Get()
+	require.NoError(t, err)
+	require.Equal(t, value, again)
+	ok, err = desktop.Authenticate(value)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	renewed, err := desktop.Renew()
+	require.NoError(t, err)
+	require.NotEqual(t, value, renewed)
+	ok, err = desktop.Authenticate(value)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = desktop.Authenticate(renewed)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestRequiredScope(t *testing.T) {
+	testCases := []struct {
+		method    string
+		path      string
+		scope     Scope
+		protected bool
+	}{
+		{http.MethodGet, "/v1/version", "", false},
+		{http.MethodGet, "/v1/docs/index.html", "", false},
+		{http.MethodGet, "/v1/apps", ScopeReadOnly, true},
+		{http.MethodGet, "/v1/system/resources", ScopeReadOnly, true},
+		{http.MethodDelete, "/v1/apps/abc", ScopeApps, true},
+		{http.MethodPut, "/v1/properties/default", ScopeApps, true},
+		{http.MethodPut, "/v1/system/update/apply", ScopeSystem, true},
+		{http.MethodPost, "/v1/models", ScopeSystem, true},
+		{http.MethodGet, "/v1/monitor/ws", ScopeApps, true},
+		{http.MethodGet, "/debug/pprof/", ScopeSystem, true},
+		{http.MethodGet, "/v1/system/audit", ScopeSystem, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			scope, protected := RequiredScope(tc.method, tc.path)
+			require.Equal(t, tc.protected, protected)
+			require.Equal(t, tc.scope, scope)
+		})
+	}
+
+	require.True(t, Grants([]Scope{ScopeApps}, ScopeReadOnly))
+	require.False(t, Grants([]Scope{ScopeReadOnly}, ScopeApps))
+	require.False(t, Grants([]Scope{ScopeApps}, ScopeSystem))
+	require.True(t, Grants([]Scope{ScopeSystem}, ScopeSystem))
+}
@@ -0,0 +1,41 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package auth
+
+import "context"
+
+// Callers that are not identified by a token.
+const (
+	CallerDesktopClient   = "desktop-client"
+	CallerLocal           = "local"
+	CallerUnauthenticated = "unauthenticated"
+)
+
+type callerKey struct{}
+
+// WithCaller stores in the context who performs the request.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns who performs the request: "token:<id>" for the requests
+// authenticated with a token, CallerDesktopClient or CallerLocal.
+func CallerFromContext(ctx context.Context) string {
+	if caller, ok := ctx.Value(callerKey{}).(string); ok {
+		return caller
+	}
+	return CallerLocal
+}
@@ -0,0 +1,91 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+)
+
+// desktopTokenPrefix tells the desktop token apart from the issued tokens.
+const desktopTokenPrefix = "aacd_"
+
+// DesktopToken is the secret identifying the desktop client. It is issued at install time and
+// kept in clear in a file readable only by the user running the daemon, where the desktop
+// client reads it: unlike the headers of a request, it cannot be forged by a web page.
+type DesktopToken struct {
+	file *paths.Path
+}
+
+func NewDesktopToken(file *paths.Path) *DesktopToken {
+	return &DesktopToken{file: file}
+}
+
+// Get returns the desktop token, issuing it if it does not exist yet.
+func (d *DesktopToken) Get() (string, error) {
+	value, err := d.read()
+	if err != nil || value != "" {
+		return value, err
+	}
+	return d.Renew()
+}
+
+// Renew issues a new desktop token, the previous one is not accepted anymore.
+func (d *DesktopToken) Renew() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	value := desktopTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	if err := d.file.Parent().MkdirAll(); err != nil {
+		return "", err
+	}
+	if err := fatomic.WriteFile(d.file.String(), []byte(value+"\n"), 0600); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Authenticate reports if the value is the desktop token. It is false until the token is issued.
+func (d *DesktopToken) Authenticate(value string) (bool, error) {
+	if !strings.HasPrefix(value, desktopTokenPrefix) {
+		return false, nil
+	}
+	expected, err := d.read()
+	if err != nil || expected == "" {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(value), []byte(expected)) == 1, nil
+}
+
+func (d *DesktopToken) read() (string, error) {
+	content, err := d.file.ReadFile()
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
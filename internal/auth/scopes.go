@@ -0,0 +1,78 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package auth issues the tokens used to call the HTTP API and maps the API endpoints to
+// the scopes required to call them.
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+type Scope string
+
+const (
+	// ScopeReadOnly allows the requests that do not change anything.
+	ScopeReadOnly Scope = "read-only"
+	// ScopeApps allows to manage the apps, their bricks and properties.
+	ScopeApps Scope = "apps"
+	// ScopeSystem allows to manage the system: updates, assets and models.
+	ScopeSystem Scope = "system"
+)
+
+// AllScopes returns the valid scopes.
+func AllScopes() []Scope {
+	return []Scope{ScopeReadOnly, ScopeApps, ScopeSystem}
+}
+
+func ParseScope(s string) (Scope, error) {
+	if !slices.Contains(AllScopes(), Scope(s)) {
+		return "", fmt.Errorf("invalid scope %q, valid scopes are %v", s, AllScopes())
+	}
+	return Scope(s), nil
+}
+
+// Grants reports if a token with the given scopes can perform a request requiring the scope.
+// Every scope grants the read-only requests.
+func Grants(scopes []Scope, required Scope) bool {
+	if required == ScopeReadOnly {
+		return len(scopes) > 0
+	}
+	return slices.Contains(scopes, required)
+}
+
+// RequiredScope returns the scope required to call an endpoint of the API, or false if the
+// endpoint is public.
+func RequiredScope(method, path string) (Scope, bool) {
+	switch {
+	case path == "/v1/version" || strings.HasPrefix(path, "/v1/docs/"):
+		return "", false
+	case strings.HasPrefix(path, "/debug/") || path == "/v1/system/audit":
+		// The audit log reveals who uses the board and how.
+		return ScopeSystem, true
+	case path == "/v1/monitor/ws":
+		// The monitor writes to the serial port of the sketch.
+		return ScopeApps, true
+	case method == http.MethodGet || method == http.MethodHead:
+		return ScopeReadOnly, true
+	case strings.HasPrefix(path, "/v1/apps") || strings.HasPrefix(path, "/v1/properties"):
+		return ScopeApps, true
+	default:
+		return ScopeSystem, true
+	}
+}
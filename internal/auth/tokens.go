@@ -0,0 +1,195 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+)
+
+var ErrTokenNotFound = errors.New("token not found")
+
+// tokenPrefix makes the tokens recognizable, for example by secret scanners.
+const tokenPrefix = "aac_"
+
+// Token is an issued token. Only the hash of the secret is stored.
+type Token struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Scopes    []Scope   `json:"scopes"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Store keeps the tokens in a file, that is read again when it changes so that the tokens
+// issued with the CLI are accepted by a running daemon.
+type Store struct {
+	file *paths.Path
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	tokens  []Token
+}
+
+func NewStore(file *paths.Path) *Store {
+	return &Store{file: file}
+}
+
+// Create issues a new token. The returned secret is not stored and cannot be retrieved again.
+func (s *Store) Create(name string, scopes []Scope) (string, Token, error) {
+	if name == "" {
+		return "", Token{}, errors.New("the name of the token is required")
+	}
+	if len(scopes) == 0 {
+		return "", Token{}, errors.New("at least a scope is required")
+	}
+	for _, scope := range scopes {
+		if _, err := ParseScope(string(scope)); err != nil {
+			return "", Token{}, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.load()
+	if err != nil {
+		return "", Token{}, err
+	}
+
+	id := make([]byte, 4)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", Token{}, err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", Token{}, err
+	}
+	value := tokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	token := Token{
+		ID:        hex.EncodeToString(id),
+		Name:      name,
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		Hash:      hashToken(value),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.save(append(tokens, token)); err != nil {
+		return "", Token{}, err
+	}
+	return value, token, nil
+}
+
+func (s *Store) List() ([]Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.load()
+	return slices.Clone(tokens), err
+}
+
+func (s *Store) Revoke(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(tokens, func(t Token) bool { return t.ID == id })
+	if idx == -1 {
+		return fmt.Errorf("%w: %s", ErrTokenNotFound, id)
+	}
+	return s.save(slices.Delete(slices.Clone(tokens), idx, idx+1))
+}
+
+// Authenticate returns the token matching the secret.
+func (s *Store) Authenticate(value string) (Token, bool, error) {
+	if !strings.HasPrefix(value, tokenPrefix) {
+		return Token{}, false, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.load()
+	if err != nil {
+		return Token{}, false, err
+	}
+	hash := []byte(hashToken(value))
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare(hash, []byte(t.Hash)) == 1 {
+			return t, true, nil
+		}
+	}
+	return Token{}, false, nil
+}
+
+// load returns the tokens, reading the file only if it has changed.
+func (s *Store) load() ([]Token, error) {
+	info, err := s.file.Stat()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			s.tokens = nil
+			return nil, nil
+		}
+		return nil, err
+	}
+	if s.tokens != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.tokens, nil
+	}
+	content, err := s.file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	tokens := []Token{}
+	if err := json.Unmarshal(content, &tokens); err != nil {
+		return nil, fmt.Errorf("invalid tokens file %s: %w", s.file, err)
+	}
+	s.tokens, s.modTime, s.size = tokens, info.ModTime(), info.Size()
+	return tokens, nil
+}
+
+func (s *Store) save(tokens []Token) error {
+	content, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := s.file.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	if err := fatomic.WriteFile(s.file.String(), content, 0600); err != nil {
+		return err
+	}
+	// Force a reload, the modification time may not change within its resolution.
+	s.tokens = nil
+	return nil
+}
+
+func hashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
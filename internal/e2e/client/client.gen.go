@@ -12,10 +12,15 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for PackageType.
 const (
 	ArduinoPlatform PackageType = "arduino-platform"
@@ -43,13 +48,15 @@ const (
 
 // AIModelItem defines model for AIModelItem.
 type AIModelItem struct {
-	BrickIds           *[]string          `json:"brick_ids"`
-	Description        *string            `json:"description,omitempty"`
-	Id                 *string            `json:"id,omitempty"`
-	Metadata           *map[string]string `json:"metadata,omitempty"`
-	ModelConfiguration *map[string]string `json:"model_configuration,omitempty"`
-	Name               *string            `json:"name,omitempty"`
-	Runner             *string            `json:"runner,omitempty"`
+	BrickIds           *[]string             `json:"brick_ids"`
+	Compatibility      *[]BrickCompatibility `json:"compatibility,omitempty"`
+	Description        *string               `json:"description,omitempty"`
+	Id                 *string               `json:"id,omitempty"`
+	Metadata           *map[string]string    `json:"metadata,omitempty"`
+	ModelConfiguration *map[string]string    `json:"model_configuration,omitempty"`
+	Name               *string               `json:"name,omitempty"`
+	Parameters         *ModelParameters      `json:"parameters,omitempty"`
+	Runner             *string               `json:"runner,omitempty"`
 }
 
 // AIModelsListResult defines model for AIModelsListResult.
@@ -57,6 +64,17 @@ type AIModelsListResult struct {
 	Models *[]AIModelItem `json:"models"`
 }
 
+// AppArchiveManifest defines model for AppArchiveManifest.
+type AppArchiveManifest struct {
+	Bricks        *[]string  `json:"bricks,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	FormatVersion *int       `json:"format_version,omitempty"`
+	IncludesData  *bool      `json:"includes_data,omitempty"`
+	Models        *[]string  `json:"models,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+	RunnerVersion *string    `json:"runner_version,omitempty"`
+}
+
 // AppBrickInstancesResult defines model for AppBrickInstancesResult.
 type AppBrickInstancesResult struct {
 	Bricks *[]BrickInstance `json:"bricks"`
@@ -79,11 +97,22 @@ type AppDetailedInfo struct {
 	Id          string              `json:"id"`
 	Name        string              `json:"name"`
 	Path        *string             `json:"path,omitempty"`
+	Restarts    *AppRestartInfo     `json:"restarts,omitempty"`
+	Sketch      *AppSketchInfo      `json:"sketch,omitempty"`
 
 	// Status Application status
 	Status Status `json:"status"`
 }
 
+// AppDiagnostic defines model for AppDiagnostic.
+type AppDiagnostic struct {
+	Column   *int    `json:"column,omitempty"`
+	Field    *string `json:"field,omitempty"`
+	Line     *int    `json:"line,omitempty"`
+	Message  string  `json:"message"`
+	Severity string  `json:"severity"`
+}
+
 // AppInfo defines model for AppInfo.
 type AppInfo struct {
 	Default     *bool   `json:"default,omitempty"`
@@ -119,12 +148,120 @@ type AppReference struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// AppRestartInfo defines model for AppRestartInfo.
+type AppRestartInfo struct {
+	Count            *int       `json:"count,omitempty"`
+	LastExitAt       *time.Time `json:"last_exit_at"`
+	LastExitCode     *int       `json:"last_exit_code"`
+	LastExitReason   *string    `json:"last_exit_reason,omitempty"`
+	LastRestartAt    *time.Time `json:"last_restart_at"`
+	Retries          *int       `json:"retries,omitempty"`
+	RetriesExhausted *bool      `json:"retries_exhausted,omitempty"`
+	SketchReuploads  *int       `json:"sketch_reuploads,omitempty"`
+}
+
+// AppRun defines model for AppRun.
+type AppRun struct {
+	Action          string         `json:"action"`
+	Error           *string        `json:"error,omitempty"`
+	FinishedAt      *time.Time     `json:"finished_at"`
+	Id              int            `json:"id"`
+	Output          *[]string      `json:"output"`
+	OutputTruncated *bool          `json:"output_truncated,omitempty"`
+	Phases          *[]AppRunPhase `json:"phases"`
+	StartedAt       time.Time      `json:"started_at"`
+	Status          string         `json:"status"`
+}
+
+// AppRunPhase defines model for AppRunPhase.
+type AppRunPhase struct {
+	At       *time.Time `json:"at,omitempty"`
+	Name     *string    `json:"name,omitempty"`
+	Progress *float32   `json:"progress,omitempty"`
+}
+
+// AppRunsResult defines model for AppRunsResult.
+type AppRunsResult struct {
+	Runs *[]AppRun `json:"runs"`
+}
+
+// AppSecretNamesResponse defines model for AppSecretNamesResponse.
+type AppSecretNamesResponse struct {
+	Names *[]string `json:"names"`
+}
+
+// AppSecretUpsertRequest defines model for AppSecretUpsertRequest.
+type AppSecretUpsertRequest struct {
+	Value *string `json:"value,omitempty"`
+}
+
+// AppSketchInfo defines model for AppSketchInfo.
+type AppSketchInfo struct {
+	Hash       string    `json:"hash"`
+	Modified   *bool     `json:"modified,omitempty"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+// AppValidationResult defines model for AppValidationResult.
+type AppValidationResult struct {
+	Diagnostics *[]AppDiagnostic `json:"diagnostics"`
+	File        string           `json:"file"`
+	Valid       bool             `json:"valid"`
+}
+
+// AssetsPinRequest defines model for AssetsPinRequest.
+type AssetsPinRequest struct {
+	Version *string `json:"version,omitempty"`
+}
+
+// AssetsReloadResponse defines model for AssetsReloadResponse.
+type AssetsReloadResponse struct {
+	Bricks   *int       `json:"bricks,omitempty"`
+	LoadedAt *time.Time `json:"loaded_at,omitempty"`
+	Models   *int       `json:"models,omitempty"`
+	Path     *string    `json:"path,omitempty"`
+}
+
+// AssetsVersionsResponse defines model for AssetsVersionsResponse.
+type AssetsVersionsResponse struct {
+	Versions *[]Version `json:"versions"`
+}
+
+// AuditResponse defines model for AuditResponse.
+type AuditResponse struct {
+	Entries *[]Entry `json:"entries"`
+}
+
+// AuditSettings defines model for AuditSettings.
+type AuditSettings struct {
+	MaxFiles *int    `json:"max_files,omitempty"`
+	MaxSize  *string `json:"max_size,omitempty"`
+}
+
+// AuthSettings defines model for AuthSettings.
+type AuthSettings struct {
+	DesktopClientExempt *bool `json:"desktop_client_exempt,omitempty"`
+	Enabled             *bool `json:"enabled,omitempty"`
+}
+
+// BrickCompatibility defines model for BrickCompatibility.
+type BrickCompatibility struct {
+	BrickId    *string `json:"brick_id,omitempty"`
+	Compatible *bool   `json:"compatible,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
 // BrickConfigVariable defines model for BrickConfigVariable.
 type BrickConfigVariable struct {
-	Description *string `json:"description,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	Required    *bool   `json:"required,omitempty"`
-	Value       *string `json:"value,omitempty"`
+	Choices     *[]string `json:"choices,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Max         *float32  `json:"max"`
+	Min         *float32  `json:"min"`
+	Name        *string   `json:"name,omitempty"`
+	Pattern     *string   `json:"pattern,omitempty"`
+	Required    *bool     `json:"required,omitempty"`
+	Type        *string   `json:"type,omitempty"`
+	Value       *string   `json:"value,omitempty"`
 }
 
 // BrickCreateUpdateRequest defines model for BrickCreateUpdateRequest.
@@ -180,9 +317,14 @@ type BrickListResult struct {
 
 // BrickVariable defines model for BrickVariable.
 type BrickVariable struct {
-	DefaultValue *string `json:"default_value,omitempty"`
-	Description  *string `json:"description,omitempty"`
-	Required     *bool   `json:"required,omitempty"`
+	Choices      *[]string `json:"choices,omitempty"`
+	DefaultValue *string   `json:"default_value,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	Max          *float32  `json:"max"`
+	Min          *float32  `json:"min"`
+	Pattern      *string   `json:"pattern,omitempty"`
+	Required     *bool     `json:"required,omitempty"`
+	Type         *string   `json:"type,omitempty"`
 }
 
 // BrokenAppInfo defines model for BrokenAppInfo.
@@ -220,6 +362,7 @@ type ConfigDirectories struct {
 // ConfigResponse defines model for ConfigResponse.
 type ConfigResponse struct {
 	Directories *ConfigDirectories `json:"directories,omitempty"`
+	Settings    *Settings          `json:"settings,omitempty"`
 }
 
 // CreateAppRequest defines model for CreateAppRequest.
@@ -239,6 +382,13 @@ type CreateAppResponse struct {
 	Id *string `json:"id,omitempty"`
 }
 
+// DaemonSettings defines model for DaemonSettings.
+type DaemonSettings struct {
+	CorsOrigins *[]string       `json:"cors_origins"`
+	Port        *string         `json:"port,omitempty"`
+	Remote      *RemoteSettings `json:"remote,omitempty"`
+}
+
 // EditRequest defines model for EditRequest.
 type EditRequest struct {
 	Default *bool `json:"default"`
@@ -253,12 +403,46 @@ type EditRequest struct {
 	Name *string `json:"name"`
 }
 
+// Entry defines model for Entry.
+type Entry struct {
+	AppId     *string                 `json:"app_id,omitempty"`
+	Caller    *string                 `json:"caller,omitempty"`
+	Error     *string                 `json:"error,omitempty"`
+	Operation *string                 `json:"operation,omitempty"`
+	Params    *map[string]interface{} `json:"params,omitempty"`
+	Result    *string                 `json:"result,omitempty"`
+	Source    *string                 `json:"source,omitempty"`
+	Status    *int                    `json:"status,omitempty"`
+	Time      *time.Time              `json:"time,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	Code    *int    `json:"code,omitempty"`
+	Details *string       `json:"details,omitempty"`
+	Errors  *[]FieldError `json:"errors,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   *string `json:"field,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// ImagesConfig defines model for ImagesConfig.
+type ImagesConfig struct {
+	Digests *map[string]string `json:"digests,omitempty"`
+	Mirrors *map[string]string `json:"mirrors,omitempty"`
+}
+
+// ImportAppResponse defines model for ImportAppResponse.
+type ImportAppResponse struct {
+	Id                    string             `json:"id"`
+	Manifest              AppArchiveManifest `json:"manifest"`
+	MissingBricks         *[]string          `json:"missing_bricks,omitempty"`
+	MissingModels         *[]string          `json:"missing_models,omitempty"`
+	RunnerVersionMismatch *bool              `json:"runner_version_mismatch,omitempty"`
+}
+
 // Library defines model for Library.
 type Library struct {
 	Architectures *[]string `json:"architectures"`
@@ -299,6 +483,25 @@ type LibraryListResponse struct {
 // LibraryReleaseID defines model for LibraryReleaseID.
 type LibraryReleaseID = map[string]interface{}
 
+// LogsSettings defines model for LogsSettings.
+type LogsSettings struct {
+	MaxFiles *int    `json:"max_files,omitempty"`
+	MaxSize  *string `json:"max_size,omitempty"`
+}
+
+// ModelParameters defines model for ModelParameters.
+type ModelParameters struct {
+	Frequency          *float32  `json:"frequency,omitempty"`
+	GpuAcceleration    *bool     `json:"gpu_acceleration,omitempty"`
+	ImageChannelCount  *int      `json:"image_channel_count,omitempty"`
+	ImageInputHeight   *int      `json:"image_input_height,omitempty"`
+	ImageInputWidth    *int      `json:"image_input_width,omitempty"`
+	InputFeaturesCount *int      `json:"input_features_count,omitempty"`
+	Labels             *[]string `json:"labels,omitempty"`
+	ModelType          *string   `json:"model_type,omitempty"`
+	Sensor             *string   `json:"sensor,omitempty"`
+}
+
 // PackageType Package type
 type PackageType string
 
@@ -329,6 +532,39 @@ type PropertyKeysResponse struct {
 	Keys *[]string `json:"keys"`
 }
 
+// PruneResult defines model for PruneResult.
+type PruneResult struct {
+	Removed    *[]string `json:"removed"`
+	SpaceFreed *int      `json:"space_freed,omitempty"`
+}
+
+// RemoteSettings defines model for RemoteSettings.
+type RemoteSettings struct {
+	Advertise *bool   `json:"advertise,omitempty"`
+	CertFile  *string `json:"cert_file,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+	KeyFile   *string `json:"key_file,omitempty"`
+	Port      *string `json:"port,omitempty"`
+}
+
+// ResourcesSettings defines model for ResourcesSettings.
+type ResourcesSettings struct {
+	CpuScrapeInterval    *string `json:"cpu_scrape_interval,omitempty"`
+	DiskScrapeInterval   *string `json:"disk_scrape_interval,omitempty"`
+	MemoryScrapeInterval *string `json:"memory_scrape_interval,omitempty"`
+}
+
+// Settings defines model for Settings.
+type Settings struct {
+	AllowMultipleApps *bool              `json:"allow_multiple_apps,omitempty"`
+	Audit             *AuditSettings     `json:"audit,omitempty"`
+	Auth              *AuthSettings      `json:"auth,omitempty"`
+	Daemon            *DaemonSettings    `json:"daemon,omitempty"`
+	Images            *ImagesConfig      `json:"images,omitempty"`
+	Logs              *LogsSettings      `json:"logs,omitempty"`
+	Resources         *ResourcesSettings `json:"resources,omitempty"`
+}
+
 // SketchAddLibraryResponse defines model for SketchAddLibraryResponse.
 type SketchAddLibraryResponse struct {
 	Libraries *[]LibraryReleaseID `json:"libraries"`
@@ -362,6 +598,17 @@ type UpgradablePackage struct {
 	Type *PackageType `json:"type,omitempty"`
 }
 
+// Version defines model for Version.
+type Version struct {
+	Default       *bool      `json:"default,omitempty"`
+	InUse         *bool      `json:"in_use,omitempty"`
+	Pinned        *bool      `json:"pinned,omitempty"`
+	ProvisionedAt *time.Time `json:"provisioned_at"`
+	RunnerImage   *string    `json:"runner_image,omitempty"`
+	Size          *int       `json:"size,omitempty"`
+	Version       *string    `json:"version,omitempty"`
+}
+
 // VersionResponse defines model for VersionResponse.
 type VersionResponse struct {
 	Version *string `json:"version,omitempty"`
@@ -373,6 +620,9 @@ type BadRequest = ErrorResponse
 // Conflict defines model for Conflict.
 type Conflict = ErrorResponse
 
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = ErrorResponse
 
@@ -382,9 +632,15 @@ type NoContent = ErrorResponse
 // NotFound defines model for NotFound.
 type NotFound = ErrorResponse
 
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
 // PreconditionFailed defines model for PreconditionFailed.
 type PreconditionFailed = ErrorResponse
 
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
 // GetAppsParams defines parameters for GetApps.
 type GetAppsParams struct {
 	// Filter Filters apps by apps,examples,default
@@ -403,12 +659,24 @@ type CreateAppParams struct {
 	SkipSketch *bool `form:"skip-sketch,omitempty" json:"skip-sketch,omitempty"`
 }
 
+// ImportAppParams defines parameters for ImportApp.
+type ImportAppParams struct {
+	// Name Name of the imported app. Defaults to the name stored in the archive.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
 // AppSketchAddLibraryParams defines parameters for AppSketchAddLibrary.
 type AppSketchAddLibraryParams struct {
 	// AddDeps if set to "true", the library's dependencies will be added as well.
 	AddDeps *string `form:"add_deps,omitempty" json:"add_deps,omitempty"`
 }
 
+// ExportAppParams defines parameters for ExportApp.
+type ExportAppParams struct {
+	// IncludeData If true, the data folder of the app is included in the archive.
+	IncludeData *bool `form:"include_data,omitempty" json:"include_data,omitempty"`
+}
+
 // GetAppLogsParams defines parameters for GetAppLogs.
 type GetAppLogsParams struct {
 	Filter   *string `form:"filter,omitempty" json:"filter,omitempty"`
@@ -416,6 +684,12 @@ type GetAppLogsParams struct {
 	Nofollow *bool   `form:"nofollow,omitempty" json:"nofollow,omitempty"`
 }
 
+// StartAppParams defines parameters for StartApp.
+type StartAppParams struct {
+	// Force If true, the sketch of another app stored in the flash of the micro is overwritten.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // ListLibrariesParams defines parameters for ListLibraries.
 type ListLibrariesParams struct {
 	// Search Search term to filter libraries by name, sentence, paragraph.
@@ -446,9 +720,45 @@ type GetAIModelsParams struct {
 	Bricks *string `form:"bricks,omitempty" json:"bricks,omitempty"`
 }
 
+// AddAIModelParams defines parameters for AddAIModel.
+type AddAIModelParams struct {
+	// Id Identifier of the model. Defaults to the slug of the name.
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Name Name of the model.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Description Description of the model.
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
+
+	// Bricks Comma separated list of the bricks that can use the model.
+	Bricks *string `form:"bricks,omitempty" json:"bricks,omitempty"`
+
+	// Labels Comma separated list of the labels of the model.
+	Labels *string `form:"labels,omitempty" json:"labels,omitempty"`
+}
+
 // UpdatePropertyJSONBody defines parameters for UpdateProperty.
 type UpdatePropertyJSONBody = string
 
+// ListAuditParams defines parameters for ListAudit.
+type ListAuditParams struct {
+	// AppId only the operations on this application.
+	AppId *string `form:"app_id,omitempty" json:"app_id,omitempty"`
+
+	// Caller only the operations of this caller, like "token:<id>" or "user:<name>".
+	Caller *string `form:"caller,omitempty" json:"caller,omitempty"`
+
+	// Operation only the operations containing this text, like "/start" or "app start".
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// Since only the operations performed from this time, in the RFC 3339 format.
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit the number of the most recent operations returned, from 1 to 1000, 100 by default.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ApplyUpdateParams defines parameters for ApplyUpdate.
 type ApplyUpdateParams struct {
 	// OnlyArduino If true, upgrade only the Arduino packages that require an upgrade. Default is false.
@@ -476,9 +786,15 @@ type EditAppJSONRequestBody = EditRequest
 // CloneAppJSONRequestBody defines body for CloneApp for application/json ContentType.
 type CloneAppJSONRequestBody = CloneRequest
 
+// UpsertAppSecretJSONRequestBody defines body for UpsertAppSecret for application/json ContentType.
+type UpsertAppSecretJSONRequestBody = AppSecretUpsertRequest
+
 // UpdatePropertyJSONRequestBody defines body for UpdateProperty for application/json ContentType.
 type UpdatePropertyJSONRequestBody = UpdatePropertyJSONBody
 
+// PinAssetsJSONRequestBody defines body for PinAssets for application/json ContentType.
+type PinAssetsJSONRequestBody = AssetsPinRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -563,6 +879,9 @@ type ClientInterface interface {
 	// GetAppsEvents request
 	GetAppsEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportAppWithBody request with any body
+	ImportAppWithBody(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppBrickInstances request
 	GetAppBrickInstances(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -585,6 +904,9 @@ type ClientInterface interface {
 	// GetAppPorts request
 	GetAppPorts(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AppSketchCompile request
+	AppSketchCompile(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AppSketchListLibraries request
 	AppSketchListLibraries(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -613,15 +935,38 @@ type ClientInterface interface {
 	// GetAppEvents request
 	GetAppEvents(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportApp request
+	ExportApp(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppLogs request
 	GetAppLogs(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ReloadApp request
+	ReloadApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAppRuns request
+	GetAppRuns(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAppSecrets request
+	GetAppSecrets(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAppSecret request
+	DeleteAppSecret(ctx context.Context, id string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpsertAppSecretWithBody request with any body
+	UpsertAppSecretWithBody(ctx context.Context, id string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpsertAppSecret(ctx context.Context, id string, name string, body UpsertAppSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// StartApp request
-	StartApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	StartApp(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StopApp request
 	StopApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ValidateApp request
+	ValidateApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetBricks request
 	GetBricks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -637,6 +982,12 @@ type ClientInterface interface {
 	// GetAIModels request
 	GetAIModels(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AddAIModelWithBody request with any body
+	AddAIModelWithBody(ctx context.Context, params *AddAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAIModel request
+	DeleteAIModel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAIModelDetails request
 	GetAIModelDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -654,6 +1005,26 @@ type ClientInterface interface {
 
 	UpdateProperty(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAssets request
+	ListAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpinAssets request
+	UnpinAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PinAssetsWithBody request with any body
+	PinAssetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PinAssets(ctx context.Context, body PinAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PruneAssets request
+	PruneAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReloadAssets request
+	ReloadAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAudit request
+	ListAudit(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSystemResources request
 	GetSystemResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -718,6 +1089,18 @@ func (c *Client) GetAppsEvents(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
+func (c *Client) ImportAppWithBody(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportAppRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAppBrickInstances(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAppBrickInstancesRequest(c.Server, appID)
 	if err != nil {
@@ -814,6 +1197,18 @@ func (c *Client) GetAppPorts(ctx context.Context, appID string, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
+func (c *Client) AppSketchCompile(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchCompileRequest(c.Server, appID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AppSketchListLibraries(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAppSketchListLibrariesRequest(c.Server, appID)
 	if err != nil {
@@ -934,6 +1329,18 @@ func (c *Client) GetAppEvents(ctx context.Context, id string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
+func (c *Client) ExportApp(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAppRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAppLogs(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAppLogsRequest(c.Server, id, params)
 	if err != nil {
@@ -946,8 +1353,80 @@ func (c *Client) GetAppLogs(ctx context.Context, id string, params *GetAppLogsPa
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartAppRequest(c.Server, id)
+func (c *Client) ReloadApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReloadAppRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAppRuns(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppRunsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAppSecrets(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppSecretsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAppSecret(ctx context.Context, id string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppSecretRequest(c.Server, id, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertAppSecretWithBody(ctx context.Context, id string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertAppSecretRequestWithBody(c.Server, id, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertAppSecret(ctx context.Context, id string, name string, body UpsertAppSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertAppSecretRequest(c.Server, id, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartApp(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartAppRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -970,6 +1449,18 @@ func (c *Client) StopApp(ctx context.Context, id string, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
+func (c *Client) ValidateApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateAppRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetBricks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetBricksRequest(c.Server)
 	if err != nil {
@@ -1030,6 +1521,30 @@ func (c *Client) GetAIModels(ctx context.Context, params *GetAIModelsParams, req
 	return c.Client.Do(req)
 }
 
+func (c *Client) AddAIModelWithBody(ctx context.Context, params *AddAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAIModelRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAIModel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAIModelRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAIModelDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAIModelDetailsRequest(c.Server, id)
 	if err != nil {
@@ -1102,8 +1617,8 @@ func (c *Client) UpdateProperty(ctx context.Context, key string, body UpdateProp
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSystemResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSystemResourcesRequest(c.Server)
+func (c *Client) ListAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAssetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1114,8 +1629,8 @@ func (c *Client) GetSystemResources(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApplyUpdate(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApplyUpdateRequest(c.Server, params)
+func (c *Client) UnpinAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpinAssetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1126,8 +1641,8 @@ func (c *Client) ApplyUpdate(ctx context.Context, params *ApplyUpdateParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CheckUpdate(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCheckUpdateRequest(c.Server, params)
+func (c *Client) PinAssetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPinAssetsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1138,8 +1653,8 @@ func (c *Client) CheckUpdate(ctx context.Context, params *CheckUpdateParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventsUpdate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventsUpdateRequest(c.Server)
+func (c *Client) PinAssets(ctx context.Context, body PinAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPinAssetsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1150,8 +1665,8 @@ func (c *Client) EventsUpdate(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVersionsRequest(c.Server)
+func (c *Client) PruneAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPruneAssetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1162,25 +1677,109 @@ func (c *Client) GetVersions(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetAppsRequest generates requests for GetApps
-func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ReloadAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReloadAssetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/apps")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListAudit(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSystemResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSystemResourcesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyUpdate(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyUpdateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckUpdate(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckUpdateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventsUpdate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventsUpdateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVersionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAppsRequest generates requests for GetApps
+func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
 	if params != nil {
 		queryValues := queryURL.Query()
 
@@ -1332,6 +1931,57 @@ func NewGetAppsEventsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewImportAppRequestWithBody generates requests for ImportApp with any type of body
+func NewImportAppRequestWithBody(server string, params *ImportAppParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apps/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetAppBrickInstancesRequest generates requests for GetAppBrickInstances
 func NewGetAppBrickInstancesRequest(server string, appID string) (*http.Request, error) {
 	var err error
@@ -1590,6 +2240,40 @@ func NewGetAppPortsRequest(server string, appID string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewAppSketchCompileRequest generates requests for AppSketchCompile
+func NewAppSketchCompileRequest(server string, appID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/compile", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewAppSketchListLibrariesRequest generates requests for AppSketchListLibraries
 func NewAppSketchListLibrariesRequest(server string, appID string) (*http.Request, error) {
 	var err error
@@ -1924,6 +2608,62 @@ func NewGetAppEventsRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewExportAppRequest generates requests for ExportApp
+func NewExportAppRequest(server string, id string, params *ExportAppParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apps/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_data", runtime.ParamLocationQuery, *params.IncludeData); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetAppLogsRequest generates requests for GetAppLogs
 func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*http.Request, error) {
 	var err error
@@ -2012,8 +2752,8 @@ func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*
 	return req, nil
 }
 
-// NewStartAppRequest generates requests for StartApp
-func NewStartAppRequest(server string, id string) (*http.Request, error) {
+// NewReloadAppRequest generates requests for ReloadApp
+func NewReloadAppRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2028,7 +2768,7 @@ func NewStartAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/start", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/reload", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2046,8 +2786,8 @@ func NewStartAppRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewStopAppRequest generates requests for StopApp
-func NewStopAppRequest(server string, id string) (*http.Request, error) {
+// NewGetAppRunsRequest generates requests for GetAppRuns
+func NewGetAppRunsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2062,7 +2802,7 @@ func NewStopAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/stop", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/runs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2072,7 +2812,7 @@ func NewStopAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2080,16 +2820,23 @@ func NewStopAppRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetBricksRequest generates requests for GetBricks
-func NewGetBricksRequest(server string) (*http.Request, error) {
+// NewGetAppSecretsRequest generates requests for GetAppSecrets
+func NewGetAppSecretsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/bricks")
+	operationPath := fmt.Sprintf("/v1/apps/%s/secrets", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2107,8 +2854,8 @@ func NewGetBricksRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetBrickDetailsRequest generates requests for GetBrickDetails
-func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error) {
+// NewDeleteAppSecretRequest generates requests for DeleteAppSecret
+func NewDeleteAppSecretRequest(server string, id string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2118,12 +2865,19 @@ func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/bricks/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/secrets/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2133,7 +2887,7 @@ func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2141,43 +2895,41 @@ func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetConfigRequest generates requests for GetConfig
-func NewGetConfigRequest(server string) (*http.Request, error) {
+// NewUpsertAppSecretRequest calls the generic UpsertAppSecret builder with application/json body
+func NewUpsertAppSecretRequest(server string, id string, name string, body UpsertAppSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertAppSecretRequestWithBody(server, id, name, "application/json", bodyReader)
+}
+
+// NewUpsertAppSecretRequestWithBody generates requests for UpsertAppSecret with any type of body
+func NewUpsertAppSecretRequestWithBody(server string, id string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/config")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam1 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewListLibrariesRequest generates requests for ListLibraries
-func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/libraries")
+	operationPath := fmt.Sprintf("/v1/apps/%s/secrets/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2187,126 +2939,33 @@ func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Architecture != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "architecture", runtime.ParamLocationQuery, *params.Architecture); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Platform != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "platform", runtime.ParamLocationQuery, *params.Platform); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Sort != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetAIModelsRequest generates requests for GetAIModels
-func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Request, error) {
+// NewStartAppRequest generates requests for StartApp
+func NewStartAppRequest(server string, id string, params *StartAppParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/models")
+	operationPath := fmt.Sprintf("/v1/apps/%s/start", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2319,9 +2978,9 @@ func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Bricks != nil {
+		if params.Force != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bricks", runtime.ParamLocationQuery, *params.Bricks); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2338,7 +2997,7 @@ func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Requ
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2346,8 +3005,8 @@ func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Requ
 	return req, nil
 }
 
-// NewGetAIModelDetailsRequest generates requests for GetAIModelDetails
-func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error) {
+// NewStopAppRequest generates requests for StopApp
+func NewStopAppRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2362,34 +3021,7 @@ func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetPropertyKeysRequest generates requests for GetPropertyKeys
-func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v1/properties")
+	operationPath := fmt.Sprintf("/v1/apps/%s/stop", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2399,7 +3031,7 @@ func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2407,13 +3039,13 @@ func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeletePropertyRequest generates requests for DeleteProperty
-func NewDeletePropertyRequest(server string, key string) (*http.Request, error) {
+// NewValidateAppRequest generates requests for ValidateApp
+func NewValidateAppRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2423,7 +3055,7 @@ func NewDeletePropertyRequest(server string, key string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/validate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2433,7 +3065,7 @@ func NewDeletePropertyRequest(server string, key string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2441,23 +3073,16 @@ func NewDeletePropertyRequest(server string, key string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetPropertyRequest generates requests for GetProperty
-func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
+// NewGetBricksRequest generates requests for GetBricks
+func NewGetBricksRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/bricks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2475,24 +3100,13 @@ func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdatePropertyRequest calls the generic UpdateProperty builder with application/json body
-func NewUpdatePropertyRequest(server string, key string, body UpdatePropertyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePropertyRequestWithBody(server, key, "application/json", bodyReader)
-}
-
-// NewUpdatePropertyRequestWithBody generates requests for UpdateProperty with any type of body
-func NewUpdatePropertyRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetBrickDetailsRequest generates requests for GetBrickDetails
+func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2502,7 +3116,7 @@ func NewUpdatePropertyRequestWithBody(server string, key string, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/bricks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2512,18 +3126,16 @@ func NewUpdatePropertyRequestWithBody(server string, key string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetSystemResourcesRequest generates requests for GetSystemResources
-func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
+// NewGetConfigRequest generates requests for GetConfig
+func NewGetConfigRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2531,7 +3143,7 @@ func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/resources")
+	operationPath := fmt.Sprintf("/v1/config")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2549,8 +3161,8 @@ func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewApplyUpdateRequest generates requests for ApplyUpdate
-func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Request, error) {
+// NewListLibrariesRequest generates requests for ListLibraries
+func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2558,7 +3170,7 @@ func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/update/apply")
+	operationPath := fmt.Sprintf("/v1/libraries")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2571,9 +3183,9 @@ func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.OnlyArduino != nil {
+		if params.Search != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2587,19 +3199,99 @@ func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Requ
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.Architecture != nil {
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "architecture", runtime.ParamLocationQuery, *params.Architecture); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewCheckUpdateRequest generates requests for CheckUpdate
-func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Request, error) {
+		if params.Platform != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "platform", runtime.ParamLocationQuery, *params.Platform); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAIModelsRequest generates requests for GetAIModels
+func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2607,7 +3299,7 @@ func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/update/check")
+	operationPath := fmt.Sprintf("/v1/models")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2620,9 +3312,9 @@ func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.OnlyArduino != nil {
+		if params.Bricks != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bricks", runtime.ParamLocationQuery, *params.Bricks); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2647,8 +3339,8 @@ func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Requ
 	return req, nil
 }
 
-// NewEventsUpdateRequest generates requests for EventsUpdate
-func NewEventsUpdateRequest(server string) (*http.Request, error) {
+// NewAddAIModelRequestWithBody generates requests for AddAIModel with any type of body
+func NewAddAIModelRequestWithBody(server string, params *AddAIModelParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2656,7 +3348,7 @@ func NewEventsUpdateRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/update/events")
+	operationPath := fmt.Sprintf("/v1/models")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2666,24 +3358,119 @@ func NewEventsUpdateRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Description != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Bricks != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bricks", runtime.ParamLocationQuery, *params.Bricks); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetVersionsRequest generates requests for GetVersions
-func NewGetVersionsRequest(server string) (*http.Request, error) {
+// NewDeleteAIModelRequest generates requests for DeleteAIModel
+func NewDeleteAIModelRequest(server string, id string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/version")
+	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2693,7 +3480,7 @@ func NewGetVersionsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2701,1386 +3488,3668 @@ func NewGetVersionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetAIModelDetailsRequest generates requests for GetAIModelDetails
+func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAppsWithResponse request
-	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error)
+// NewGetPropertyKeysRequest generates requests for GetPropertyKeys
+func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
+	var err error
 
-	// CreateAppWithBodyWithResponse request with any body
-	CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+	operationPath := fmt.Sprintf("/v1/properties")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetAppsEventsWithResponse request
-	GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error)
-
-	// GetAppBrickInstancesWithResponse request
-	GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error)
-
-	// DeleteAppBrickInstanceWithResponse request
-	DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error)
-
-	// GetAppBrickInstanceByBrickIDWithResponse request
-	GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAppBrickInstanceWithBodyWithResponse request with any body
-	UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+	return req, nil
+}
 
-	// UpsertAppBrickInstanceWithBodyWithResponse request with any body
-	UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+// NewDeletePropertyRequest generates requests for DeleteProperty
+func NewDeletePropertyRequest(server string, key string) (*http.Request, error) {
+	var err error
 
-	UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+	var pathParam0 string
 
-	// GetAppPortsWithResponse request
-	GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
 
-	// AppSketchListLibrariesWithResponse request
-	AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AppSketchRemoveLibraryWithResponse request
-	AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error)
+	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AppSketchAddLibraryWithResponse request
-	AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAppWithResponse request
-	DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAppDetailsWithResponse request
-	GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error)
+	return req, nil
+}
 
-	// EditAppWithBodyWithResponse request with any body
-	EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+// NewGetPropertyRequest generates requests for GetProperty
+func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
+	var err error
 
-	EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+	var pathParam0 string
 
-	// CloneAppWithBodyWithResponse request with any body
-	CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
 
-	CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAppEventsWithResponse request
-	GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error)
+	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetAppLogsWithResponse request
-	GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StartAppWithResponse request
-	StartAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartAppResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// StopAppWithResponse request
-	StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error)
+	return req, nil
+}
 
-	// GetBricksWithResponse request
-	GetBricksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBricksResp, error)
+// NewUpdatePropertyRequest calls the generic UpdateProperty builder with application/json body
+func NewUpdatePropertyRequest(server string, key string, body UpdatePropertyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePropertyRequestWithBody(server, key, "application/json", bodyReader)
+}
 
-	// GetBrickDetailsWithResponse request
-	GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error)
+// NewUpdatePropertyRequestWithBody generates requests for UpdateProperty with any type of body
+func NewUpdatePropertyRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetConfigWithResponse request
-	GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error)
+	var pathParam0 string
 
-	// ListLibrariesWithResponse request
-	ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAIModelsWithResponse request
-	GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAIModelDetailsWithResponse request
-	GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error)
+	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetPropertyKeysWithResponse request
-	GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeletePropertyWithResponse request
-	DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetPropertyWithResponse request
-	GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UpdatePropertyWithBodyWithResponse request with any body
-	UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+	return req, nil
+}
 
-	UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+// NewListAssetsRequest generates requests for ListAssets
+func NewListAssetsRequest(server string) (*http.Request, error) {
+	var err error
 
-	// GetSystemResourcesWithResponse request
-	GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApplyUpdateWithResponse request
-	ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error)
+	operationPath := fmt.Sprintf("/v1/system/assets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CheckUpdateWithResponse request
-	CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventsUpdateWithResponse request
-	EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetVersionsWithResponse request
-	GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error)
+	return req, nil
 }
 
-type GetAppsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AppListResponse
-	JSON500      *InternalServerError
-}
+// NewUnpinAssetsRequest generates requests for UnpinAssets
+func NewUnpinAssetsRequest(server string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetAppsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/system/assets/pin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type CreateAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreateAppResponse
-	JSON400      *BadRequest
-	JSON409      *Conflict
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateAppResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAppsEventsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppsEventsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPinAssetsRequest calls the generic PinAssets builder with application/json body
+func NewPinAssetsRequest(server string, body PinAssetsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPinAssetsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppsEventsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type GetAppBrickInstancesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AppBrickInstancesResult
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
+// NewPinAssetsRequestWithBody generates requests for PinAssets with any type of body
+func NewPinAssetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetAppBrickInstancesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppBrickInstancesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/system/assets/pin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type DeleteAppBrickInstanceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteAppBrickInstanceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppBrickInstanceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetAppBrickInstanceByBrickIDResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BrickInstance
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r GetAppBrickInstanceByBrickIDResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppBrickInstanceByBrickIDResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type UpdateAppBrickInstanceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
+// NewPruneAssetsRequest generates requests for PruneAssets
+func NewPruneAssetsRequest(server string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r UpdateAppBrickInstanceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppBrickInstanceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/system/assets/prune")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type UpsertAppBrickInstanceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r UpsertAppBrickInstanceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpsertAppBrickInstanceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAppPortsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AppPortResponse
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppPortsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewReloadAssetsRequest generates requests for ReloadAssets
+func NewReloadAssetsRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppPortsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AppSketchListLibrariesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SketchListLibraryResponse
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/v1/system/assets/reload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r AppSketchListLibrariesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AppSketchListLibrariesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AppSketchRemoveLibraryResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SketchRemoveLibraryResponse
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AppSketchRemoveLibraryResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListAuditRequest generates requests for ListAudit
+func NewListAuditRequest(server string, params *ListAuditParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AppSketchRemoveLibraryResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type AppSketchAddLibraryResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SketchAddLibraryResponse
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r AppSketchAddLibraryResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/system/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AppSketchAddLibraryResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteAppResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.AppId != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "app_id", runtime.ParamLocationQuery, *params.AppId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Caller != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "caller", runtime.ParamLocationQuery, *params.Caller); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Operation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSystemResourcesRequest generates requests for GetSystemResources
+func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApplyUpdateRequest generates requests for ApplyUpdate
+func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/update/apply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OnlyArduino != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckUpdateRequest generates requests for CheckUpdate
+func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/update/check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OnlyArduino != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEventsUpdateRequest generates requests for EventsUpdate
+func NewEventsUpdateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/update/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVersionsRequest generates requests for GetVersions
+func NewGetVersionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAppsWithResponse request
+	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error)
+
+	// CreateAppWithBodyWithResponse request with any body
+	CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+
+	CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+
+	// GetAppsEventsWithResponse request
+	GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error)
+
+	// ImportAppWithBodyWithResponse request with any body
+	ImportAppWithBodyWithResponse(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAppResp, error)
+
+	// GetAppBrickInstancesWithResponse request
+	GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error)
+
+	// DeleteAppBrickInstanceWithResponse request
+	DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error)
+
+	// GetAppBrickInstanceByBrickIDWithResponse request
+	GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error)
+
+	// UpdateAppBrickInstanceWithBodyWithResponse request with any body
+	UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+
+	UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+
+	// UpsertAppBrickInstanceWithBodyWithResponse request with any body
+	UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+
+	UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+
+	// GetAppPortsWithResponse request
+	GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error)
+
+	// AppSketchCompileWithResponse request
+	AppSketchCompileWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchCompileResp, error)
+
+	// AppSketchListLibrariesWithResponse request
+	AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error)
+
+	// AppSketchRemoveLibraryWithResponse request
+	AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error)
+
+	// AppSketchAddLibraryWithResponse request
+	AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error)
+
+	// DeleteAppWithResponse request
+	DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error)
+
+	// GetAppDetailsWithResponse request
+	GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error)
+
+	// EditAppWithBodyWithResponse request with any body
+	EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+
+	EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+
+	// CloneAppWithBodyWithResponse request with any body
+	CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+
+	CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+
+	// GetAppEventsWithResponse request
+	GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error)
+
+	// ExportAppWithResponse request
+	ExportAppWithResponse(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*ExportAppResp, error)
+
+	// GetAppLogsWithResponse request
+	GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error)
+
+	// ReloadAppWithResponse request
+	ReloadAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ReloadAppResp, error)
+
+	// GetAppRunsWithResponse request
+	GetAppRunsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppRunsResp, error)
+
+	// GetAppSecretsWithResponse request
+	GetAppSecretsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppSecretsResp, error)
+
+	// DeleteAppSecretWithResponse request
+	DeleteAppSecretWithResponse(ctx context.Context, id string, name string, reqEditors ...RequestEditorFn) (*DeleteAppSecretResp, error)
+
+	// UpsertAppSecretWithBodyWithResponse request with any body
+	UpsertAppSecretWithBodyWithResponse(ctx context.Context, id string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppSecretResp, error)
+
+	UpsertAppSecretWithResponse(ctx context.Context, id string, name string, body UpsertAppSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppSecretResp, error)
+
+	// StartAppWithResponse request
+	StartAppWithResponse(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*StartAppResp, error)
+
+	// StopAppWithResponse request
+	StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error)
+
+	// ValidateAppWithResponse request
+	ValidateAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ValidateAppResp, error)
+
+	// GetBricksWithResponse request
+	GetBricksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBricksResp, error)
+
+	// GetBrickDetailsWithResponse request
+	GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error)
+
+	// GetConfigWithResponse request
+	GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error)
+
+	// ListLibrariesWithResponse request
+	ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error)
+
+	// GetAIModelsWithResponse request
+	GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error)
+
+	// AddAIModelWithBodyWithResponse request with any body
+	AddAIModelWithBodyWithResponse(ctx context.Context, params *AddAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAIModelResp, error)
+
+	// DeleteAIModelWithResponse request
+	DeleteAIModelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAIModelResp, error)
+
+	// GetAIModelDetailsWithResponse request
+	GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error)
+
+	// GetPropertyKeysWithResponse request
+	GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error)
+
+	// DeletePropertyWithResponse request
+	DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error)
+
+	// GetPropertyWithResponse request
+	GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error)
+
+	// UpdatePropertyWithBodyWithResponse request with any body
+	UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+
+	UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+
+	// ListAssetsWithResponse request
+	ListAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAssetsResp, error)
+
+	// UnpinAssetsWithResponse request
+	UnpinAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnpinAssetsResp, error)
+
+	// PinAssetsWithBodyWithResponse request with any body
+	PinAssetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PinAssetsResp, error)
+
+	PinAssetsWithResponse(ctx context.Context, body PinAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PinAssetsResp, error)
+
+	// PruneAssetsWithResponse request
+	PruneAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PruneAssetsResp, error)
+
+	// ReloadAssetsWithResponse request
+	ReloadAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadAssetsResp, error)
+
+	// ListAuditWithResponse request
+	ListAuditWithResponse(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*ListAuditResp, error)
+
+	// GetSystemResourcesWithResponse request
+	GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error)
+
+	// ApplyUpdateWithResponse request
+	ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error)
+
+	// CheckUpdateWithResponse request
+	CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error)
+
+	// EventsUpdateWithResponse request
+	EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error)
+
+	// GetVersionsWithResponse request
+	GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error)
+}
+
+type GetAppsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateAppResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppsEventsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsEventsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsEventsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ImportAppResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON413      *PayloadTooLarge
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppBrickInstancesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppBrickInstancesResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppBrickInstancesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppBrickInstancesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppBrickInstanceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppBrickInstanceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppBrickInstanceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppBrickInstanceByBrickIDResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrickInstance
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppBrickInstanceByBrickIDResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppBrickInstanceByBrickIDResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppBrickInstanceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppBrickInstanceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppBrickInstanceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertAppBrickInstanceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertAppBrickInstanceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertAppBrickInstanceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppPortsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppPortResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppPortsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppPortsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSketchCompileResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSketchCompileResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchCompileResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSketchListLibrariesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchListLibraryResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSketchListLibrariesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchListLibrariesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSketchRemoveLibraryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchRemoveLibraryResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSketchRemoveLibraryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchRemoveLibraryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSketchAddLibraryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchAddLibraryResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSketchAddLibraryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchAddLibraryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppDetailsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDetailedInfo
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppDetailsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppDetailsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDetailedInfo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r EditAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CloneAppResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppEventsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppEventsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppEventsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON413      *PayloadTooLarge
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReloadAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReloadAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReloadAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppRunsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppRunsResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppRunsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppRunsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppSecretsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppSecretNamesResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppSecretsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppSecretsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppSecretResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppSecretResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppSecretResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertAppSecretResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertAppSecretResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertAppSecretResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r StartAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r StopAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppValidationResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBricksResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrickListResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBricksResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBricksResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBrickDetailsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrickDetailsResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBrickDetailsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBrickDetailsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLibrariesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLibrariesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLibrariesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAIModelsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AIModelsListResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAIModelsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAIModelsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddAIModelResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AIModelItem
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON413      *PayloadTooLarge
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddAIModelResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddAIModelResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAIModelResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAIModelResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAIModelResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAIModelDetailsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AIModelItem
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAIModelDetailsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAIModelDetailsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPropertyKeysResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PropertyKeysResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPropertyKeysResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPropertyKeysResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePropertyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *string
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePropertyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePropertyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPropertyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPropertyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPropertyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePropertyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePropertyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePropertyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAssetsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetsVersionsResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAssetsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAssetsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnpinAssetsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UnpinAssetsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnpinAssetsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PinAssetsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PinAssetsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PinAssetsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PruneAssetsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PruneResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PruneAssetsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PruneAssetsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReloadAssetsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetsReloadResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReloadAssetsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReloadAssetsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSystemResourcesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSystemResourcesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSystemResourcesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyUpdateResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *NoContent
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyUpdateResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyUpdateResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckUpdateResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateCheckResult
+	JSON204      *NoContent
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckUpdateResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckUpdateResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventsUpdateResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r EventsUpdateResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventsUpdateResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVersionsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VersionResponse
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVersionsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVersionsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAppsWithResponse request returning *GetAppsResp
+func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error) {
+	rsp, err := c.GetApps(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsResp(rsp)
+}
+
+// CreateAppWithBodyWithResponse request with arbitrary body returning *CreateAppResp
+func (c *ClientWithResponses) CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
+	rsp, err := c.CreateAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
+	rsp, err := c.CreateApp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppResp(rsp)
+}
+
+// GetAppsEventsWithResponse request returning *GetAppsEventsResp
+func (c *ClientWithResponses) GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error) {
+	rsp, err := c.GetAppsEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsEventsResp(rsp)
+}
+
+// ImportAppWithBodyWithResponse request with arbitrary body returning *ImportAppResp
+func (c *ClientWithResponses) ImportAppWithBodyWithResponse(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAppResp, error) {
+	rsp, err := c.ImportAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportAppResp(rsp)
+}
+
+// GetAppBrickInstancesWithResponse request returning *GetAppBrickInstancesResp
+func (c *ClientWithResponses) GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error) {
+	rsp, err := c.GetAppBrickInstances(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppBrickInstancesResp(rsp)
+}
+
+// DeleteAppBrickInstanceWithResponse request returning *DeleteAppBrickInstanceResp
+func (c *ClientWithResponses) DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error) {
+	rsp, err := c.DeleteAppBrickInstance(ctx, appID, brickID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppBrickInstanceResp(rsp)
+}
+
+// GetAppBrickInstanceByBrickIDWithResponse request returning *GetAppBrickInstanceByBrickIDResp
+func (c *ClientWithResponses) GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error) {
+	rsp, err := c.GetAppBrickInstanceByBrickID(ctx, appID, brickID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppBrickInstanceByBrickIDResp(rsp)
+}
+
+// UpdateAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpdateAppBrickInstanceResp
+func (c *ClientWithResponses) UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
+	rsp, err := c.UpdateAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppBrickInstanceResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
+	rsp, err := c.UpdateAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppBrickInstanceResp(rsp)
+}
+
+// UpsertAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpsertAppBrickInstanceResp
+func (c *ClientWithResponses) UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
+	rsp, err := c.UpsertAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppBrickInstanceResp(rsp)
+}
+
+func (c *ClientWithResponses) UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
+	rsp, err := c.UpsertAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppBrickInstanceResp(rsp)
+}
+
+// GetAppPortsWithResponse request returning *GetAppPortsResp
+func (c *ClientWithResponses) GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error) {
+	rsp, err := c.GetAppPorts(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppPortsResp(rsp)
+}
+
+// AppSketchCompileWithResponse request returning *AppSketchCompileResp
+func (c *ClientWithResponses) AppSketchCompileWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchCompileResp, error) {
+	rsp, err := c.AppSketchCompile(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchCompileResp(rsp)
+}
+
+// AppSketchListLibrariesWithResponse request returning *AppSketchListLibrariesResp
+func (c *ClientWithResponses) AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error) {
+	rsp, err := c.AppSketchListLibraries(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAppSketchListLibrariesResp(rsp)
 }
 
-type GetAppDetailsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AppDetailedInfo
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+// AppSketchRemoveLibraryWithResponse request returning *AppSketchRemoveLibraryResp
+func (c *ClientWithResponses) AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error) {
+	rsp, err := c.AppSketchRemoveLibrary(ctx, appID, libRef, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchRemoveLibraryResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppDetailsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AppSketchAddLibraryWithResponse request returning *AppSketchAddLibraryResp
+func (c *ClientWithResponses) AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error) {
+	rsp, err := c.AppSketchAddLibrary(ctx, appID, libRef, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchAddLibraryResp(rsp)
+}
+
+// DeleteAppWithResponse request returning *DeleteAppResp
+func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error) {
+	rsp, err := c.DeleteApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppResp(rsp)
+}
+
+// GetAppDetailsWithResponse request returning *GetAppDetailsResp
+func (c *ClientWithResponses) GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error) {
+	rsp, err := c.GetAppDetails(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppDetailsResp(rsp)
+}
+
+// EditAppWithBodyWithResponse request with arbitrary body returning *EditAppResp
+func (c *ClientWithResponses) EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
+	rsp, err := c.EditAppWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditAppResp(rsp)
+}
+
+func (c *ClientWithResponses) EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
+	rsp, err := c.EditApp(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditAppResp(rsp)
+}
+
+// CloneAppWithBodyWithResponse request with arbitrary body returning *CloneAppResp
+func (c *ClientWithResponses) CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
+	rsp, err := c.CloneAppWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneAppResp(rsp)
+}
+
+func (c *ClientWithResponses) CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
+	rsp, err := c.CloneApp(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneAppResp(rsp)
+}
+
+// GetAppEventsWithResponse request returning *GetAppEventsResp
+func (c *ClientWithResponses) GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error) {
+	rsp, err := c.GetAppEvents(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppEventsResp(rsp)
+}
+
+// ExportAppWithResponse request returning *ExportAppResp
+func (c *ClientWithResponses) ExportAppWithResponse(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*ExportAppResp, error) {
+	rsp, err := c.ExportApp(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAppResp(rsp)
+}
+
+// GetAppLogsWithResponse request returning *GetAppLogsResp
+func (c *ClientWithResponses) GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error) {
+	rsp, err := c.GetAppLogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppLogsResp(rsp)
+}
+
+// ReloadAppWithResponse request returning *ReloadAppResp
+func (c *ClientWithResponses) ReloadAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ReloadAppResp, error) {
+	rsp, err := c.ReloadApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReloadAppResp(rsp)
+}
+
+// GetAppRunsWithResponse request returning *GetAppRunsResp
+func (c *ClientWithResponses) GetAppRunsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppRunsResp, error) {
+	rsp, err := c.GetAppRuns(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppRunsResp(rsp)
+}
+
+// GetAppSecretsWithResponse request returning *GetAppSecretsResp
+func (c *ClientWithResponses) GetAppSecretsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppSecretsResp, error) {
+	rsp, err := c.GetAppSecrets(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppSecretsResp(rsp)
+}
+
+// DeleteAppSecretWithResponse request returning *DeleteAppSecretResp
+func (c *ClientWithResponses) DeleteAppSecretWithResponse(ctx context.Context, id string, name string, reqEditors ...RequestEditorFn) (*DeleteAppSecretResp, error) {
+	rsp, err := c.DeleteAppSecret(ctx, id, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppSecretResp(rsp)
+}
+
+// UpsertAppSecretWithBodyWithResponse request with arbitrary body returning *UpsertAppSecretResp
+func (c *ClientWithResponses) UpsertAppSecretWithBodyWithResponse(ctx context.Context, id string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppSecretResp, error) {
+	rsp, err := c.UpsertAppSecretWithBody(ctx, id, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppSecretResp(rsp)
+}
+
+func (c *ClientWithResponses) UpsertAppSecretWithResponse(ctx context.Context, id string, name string, body UpsertAppSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppSecretResp, error) {
+	rsp, err := c.UpsertAppSecret(ctx, id, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppSecretResp(rsp)
+}
+
+// StartAppWithResponse request returning *StartAppResp
+func (c *ClientWithResponses) StartAppWithResponse(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*StartAppResp, error) {
+	rsp, err := c.StartApp(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartAppResp(rsp)
+}
+
+// StopAppWithResponse request returning *StopAppResp
+func (c *ClientWithResponses) StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error) {
+	rsp, err := c.StopApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopAppResp(rsp)
+}
+
+// ValidateAppWithResponse request returning *ValidateAppResp
+func (c *ClientWithResponses) ValidateAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ValidateAppResp, error) {
+	rsp, err := c.ValidateApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateAppResp(rsp)
+}
+
+// GetBricksWithResponse request returning *GetBricksResp
+func (c *ClientWithResponses) GetBricksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBricksResp, error) {
+	rsp, err := c.GetBricks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBricksResp(rsp)
+}
+
+// GetBrickDetailsWithResponse request returning *GetBrickDetailsResp
+func (c *ClientWithResponses) GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error) {
+	rsp, err := c.GetBrickDetails(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBrickDetailsResp(rsp)
+}
+
+// GetConfigWithResponse request returning *GetConfigResp
+func (c *ClientWithResponses) GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error) {
+	rsp, err := c.GetConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigResp(rsp)
+}
+
+// ListLibrariesWithResponse request returning *ListLibrariesResp
+func (c *ClientWithResponses) ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error) {
+	rsp, err := c.ListLibraries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLibrariesResp(rsp)
+}
+
+// GetAIModelsWithResponse request returning *GetAIModelsResp
+func (c *ClientWithResponses) GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error) {
+	rsp, err := c.GetAIModels(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAIModelsResp(rsp)
+}
+
+// AddAIModelWithBodyWithResponse request with arbitrary body returning *AddAIModelResp
+func (c *ClientWithResponses) AddAIModelWithBodyWithResponse(ctx context.Context, params *AddAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAIModelResp, error) {
+	rsp, err := c.AddAIModelWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAIModelResp(rsp)
+}
+
+// DeleteAIModelWithResponse request returning *DeleteAIModelResp
+func (c *ClientWithResponses) DeleteAIModelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAIModelResp, error) {
+	rsp, err := c.DeleteAIModel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAIModelResp(rsp)
+}
+
+// GetAIModelDetailsWithResponse request returning *GetAIModelDetailsResp
+func (c *ClientWithResponses) GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error) {
+	rsp, err := c.GetAIModelDetails(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAIModelDetailsResp(rsp)
+}
+
+// GetPropertyKeysWithResponse request returning *GetPropertyKeysResp
+func (c *ClientWithResponses) GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error) {
+	rsp, err := c.GetPropertyKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPropertyKeysResp(rsp)
+}
+
+// DeletePropertyWithResponse request returning *DeletePropertyResp
+func (c *ClientWithResponses) DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error) {
+	rsp, err := c.DeleteProperty(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePropertyResp(rsp)
+}
+
+// GetPropertyWithResponse request returning *GetPropertyResp
+func (c *ClientWithResponses) GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error) {
+	rsp, err := c.GetProperty(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPropertyResp(rsp)
+}
+
+// UpdatePropertyWithBodyWithResponse request with arbitrary body returning *UpdatePropertyResp
+func (c *ClientWithResponses) UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
+	rsp, err := c.UpdatePropertyWithBody(ctx, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePropertyResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppDetailsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
+	rsp, err := c.UpdateProperty(ctx, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePropertyResp(rsp)
 }
 
-type EditAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AppDetailedInfo
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+// ListAssetsWithResponse request returning *ListAssetsResp
+func (c *ClientWithResponses) ListAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAssetsResp, error) {
+	rsp, err := c.ListAssets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAssetsResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r EditAppResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UnpinAssetsWithResponse request returning *UnpinAssetsResp
+func (c *ClientWithResponses) UnpinAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnpinAssetsResp, error) {
+	rsp, err := c.UnpinAssets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUnpinAssetsResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EditAppResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PinAssetsWithBodyWithResponse request with arbitrary body returning *PinAssetsResp
+func (c *ClientWithResponses) PinAssetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PinAssetsResp, error) {
+	rsp, err := c.PinAssetsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePinAssetsResp(rsp)
 }
 
-type CloneAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CloneAppResponse
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON409      *Conflict
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+func (c *ClientWithResponses) PinAssetsWithResponse(ctx context.Context, body PinAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PinAssetsResp, error) {
+	rsp, err := c.PinAssets(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePinAssetsResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CloneAppResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PruneAssetsWithResponse request returning *PruneAssetsResp
+func (c *ClientWithResponses) PruneAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PruneAssetsResp, error) {
+	rsp, err := c.PruneAssets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePruneAssetsResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CloneAppResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ReloadAssetsWithResponse request returning *ReloadAssetsResp
+func (c *ClientWithResponses) ReloadAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadAssetsResp, error) {
+	rsp, err := c.ReloadAssets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseReloadAssetsResp(rsp)
 }
 
-type GetAppEventsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *InternalServerError
+// ListAuditWithResponse request returning *ListAuditResp
+func (c *ClientWithResponses) ListAuditWithResponse(ctx context.Context, params *ListAuditParams, reqEditors ...RequestEditorFn) (*ListAuditResp, error) {
+	rsp, err := c.ListAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppEventsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetSystemResourcesWithResponse request returning *GetSystemResourcesResp
+func (c *ClientWithResponses) GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error) {
+	rsp, err := c.GetSystemResources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetSystemResourcesResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppEventsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ApplyUpdateWithResponse request returning *ApplyUpdateResp
+func (c *ClientWithResponses) ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error) {
+	rsp, err := c.ApplyUpdate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApplyUpdateResp(rsp)
 }
 
-type GetAppLogsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+// CheckUpdateWithResponse request returning *CheckUpdateResp
+func (c *ClientWithResponses) CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error) {
+	rsp, err := c.CheckUpdate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckUpdateResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAppLogsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventsUpdateWithResponse request returning *EventsUpdateResp
+func (c *ClientWithResponses) EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error) {
+	rsp, err := c.EventsUpdate(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventsUpdateResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAppLogsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetVersionsWithResponse request returning *GetVersionsResp
+func (c *ClientWithResponses) GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error) {
+	rsp, err := c.GetVersions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetVersionsResp(rsp)
 }
 
-type StartAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
-}
+// ParseGetAppsResp parses an HTTP response from a GetAppsWithResponse call
+func ParseGetAppsResp(rsp *http.Response) (*GetAppsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r StartAppResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetAppsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartAppResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return 0
-}
 
-type StopAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StopAppResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateAppResp parses an HTTP response from a CreateAppWithResponse call
+func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StopAppResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetBricksResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BrickListResult
-	JSON500      *InternalServerError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetBricksResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBricksResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetBrickDetailsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BrickDetailsResult
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetBrickDetailsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAppsEventsResp parses an HTTP response from a GetAppsEventsWithResponse call
+func ParseGetAppsEventsResp(rsp *http.Response) (*GetAppsEventsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBrickDetailsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAppsEventsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetConfigResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ConfigResponse
-	JSON500      *InternalServerError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetConfigResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetConfigResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListLibrariesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LibraryListResponse
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListLibrariesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseImportAppResp parses an HTTP response from a ImportAppWithResponse call
+func ParseImportAppResp(rsp *http.Response) (*ImportAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListLibrariesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ImportAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetAIModelsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AIModelsListResult
-	JSON500      *InternalServerError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ImportAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetAIModelsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAIModelsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-type GetAIModelDetailsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AIModelItem
-	JSON500      *InternalServerError
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetAIModelDetailsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAIModelDetailsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetPropertyKeysResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PropertyKeysResponse
-	JSON500      *InternalServerError
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPropertyKeysResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAppBrickInstancesResp parses an HTTP response from a GetAppBrickInstancesWithResponse call
+func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPropertyKeysResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAppBrickInstancesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeletePropertyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *string
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppBrickInstancesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeletePropertyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePropertyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-type GetPropertyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetPropertyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPropertyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdatePropertyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePropertyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAppBrickInstanceResp parses an HTTP response from a DeleteAppBrickInstanceWithResponse call
+func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePropertyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAppBrickInstanceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSystemResourcesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *InternalServerError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSystemResourcesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSystemResourcesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-type ApplyUpdateResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *NoContent
-	JSON409      *Conflict
-	JSON500      *InternalServerError
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ApplyUpdateResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApplyUpdateResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type CheckUpdateResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdateCheckResult
-	JSON204      *NoContent
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CheckUpdateResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAppBrickInstanceByBrickIDResp parses an HTTP response from a GetAppBrickInstanceByBrickIDWithResponse call
+func ParseGetAppBrickInstanceByBrickIDResp(rsp *http.Response) (*GetAppBrickInstanceByBrickIDResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CheckUpdateResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAppBrickInstanceByBrickIDResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type EventsUpdateResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *InternalServerError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrickInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r EventsUpdateResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EventsUpdateResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-type GetVersionsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VersionResponse
-	JSON500      *InternalServerError
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetVersionsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVersionsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetAppsWithResponse request returning *GetAppsResp
-func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error) {
-	rsp, err := c.GetApps(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetAppsResp(rsp)
-}
 
-// CreateAppWithBodyWithResponse request with arbitrary body returning *CreateAppResp
-func (c *ClientWithResponses) CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
-	rsp, err := c.CreateAppWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateAppResp(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
-	rsp, err := c.CreateApp(ctx, params, body, reqEditors...)
+// ParseUpdateAppBrickInstanceResp parses an HTTP response from a UpdateAppBrickInstanceWithResponse call
+func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppResp(rsp)
-}
 
-// GetAppsEventsWithResponse request returning *GetAppsEventsResp
-func (c *ClientWithResponses) GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error) {
-	rsp, err := c.GetAppsEvents(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAppBrickInstanceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAppsEventsResp(rsp)
-}
 
-// GetAppBrickInstancesWithResponse request returning *GetAppBrickInstancesResp
-func (c *ClientWithResponses) GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error) {
-	rsp, err := c.GetAppBrickInstances(ctx, appID, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAppBrickInstancesResp(rsp)
-}
 
-// DeleteAppBrickInstanceWithResponse request returning *DeleteAppBrickInstanceResp
-func (c *ClientWithResponses) DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error) {
-	rsp, err := c.DeleteAppBrickInstance(ctx, appID, brickID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteAppBrickInstanceResp(rsp)
+	return response, nil
 }
 
-// GetAppBrickInstanceByBrickIDWithResponse request returning *GetAppBrickInstanceByBrickIDResp
-func (c *ClientWithResponses) GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error) {
-	rsp, err := c.GetAppBrickInstanceByBrickID(ctx, appID, brickID, reqEditors...)
+// ParseUpsertAppBrickInstanceResp parses an HTTP response from a UpsertAppBrickInstanceWithResponse call
+func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppBrickInstanceByBrickIDResp(rsp)
-}
 
-// UpdateAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpdateAppBrickInstanceResp
-func (c *ClientWithResponses) UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
-	rsp, err := c.UpdateAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpsertAppBrickInstanceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateAppBrickInstanceResp(rsp)
-}
 
-func (c *ClientWithResponses) UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
-	rsp, err := c.UpdateAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateAppBrickInstanceResp(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// UpsertAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpsertAppBrickInstanceResp
-func (c *ClientWithResponses) UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
-	rsp, err := c.UpsertAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpsertAppBrickInstanceResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
-	rsp, err := c.UpsertAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUpsertAppBrickInstanceResp(rsp)
+
+	return response, nil
 }
 
-// GetAppPortsWithResponse request returning *GetAppPortsResp
-func (c *ClientWithResponses) GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error) {
-	rsp, err := c.GetAppPorts(ctx, appID, reqEditors...)
+// ParseGetAppPortsResp parses an HTTP response from a GetAppPortsWithResponse call
+func ParseGetAppPortsResp(rsp *http.Response) (*GetAppPortsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppPortsResp(rsp)
-}
 
-// AppSketchListLibrariesWithResponse request returning *AppSketchListLibrariesResp
-func (c *ClientWithResponses) AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error) {
-	rsp, err := c.AppSketchListLibraries(ctx, appID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppPortsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAppSketchListLibrariesResp(rsp)
-}
 
-// AppSketchRemoveLibraryWithResponse request returning *AppSketchRemoveLibraryResp
-func (c *ClientWithResponses) AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error) {
-	rsp, err := c.AppSketchRemoveLibrary(ctx, appID, libRef, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppPortResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseAppSketchRemoveLibraryResp(rsp)
+
+	return response, nil
 }
 
-// AppSketchAddLibraryWithResponse request returning *AppSketchAddLibraryResp
-func (c *ClientWithResponses) AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error) {
-	rsp, err := c.AppSketchAddLibrary(ctx, appID, libRef, params, reqEditors...)
+// ParseAppSketchCompileResp parses an HTTP response from a AppSketchCompileWithResponse call
+func ParseAppSketchCompileResp(rsp *http.Response) (*AppSketchCompileResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAppSketchAddLibraryResp(rsp)
-}
 
-// DeleteAppWithResponse request returning *DeleteAppResp
-func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error) {
-	rsp, err := c.DeleteApp(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AppSketchCompileResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteAppResp(rsp)
-}
 
-// GetAppDetailsWithResponse request returning *GetAppDetailsResp
-func (c *ClientWithResponses) GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error) {
-	rsp, err := c.GetAppDetails(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAppDetailsResp(rsp)
+
+	return response, nil
 }
 
-// EditAppWithBodyWithResponse request with arbitrary body returning *EditAppResp
-func (c *ClientWithResponses) EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
-	rsp, err := c.EditAppWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseAppSketchListLibrariesResp parses an HTTP response from a AppSketchListLibrariesWithResponse call
+func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrariesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEditAppResp(rsp)
-}
 
-func (c *ClientWithResponses) EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
-	rsp, err := c.EditApp(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AppSketchListLibrariesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseEditAppResp(rsp)
-}
 
-// CloneAppWithBodyWithResponse request with arbitrary body returning *CloneAppResp
-func (c *ClientWithResponses) CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
-	rsp, err := c.CloneAppWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SketchListLibraryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCloneAppResp(rsp)
-}
 
-func (c *ClientWithResponses) CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
-	rsp, err := c.CloneApp(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCloneAppResp(rsp)
+	return response, nil
 }
 
-// GetAppEventsWithResponse request returning *GetAppEventsResp
-func (c *ClientWithResponses) GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error) {
-	rsp, err := c.GetAppEvents(ctx, id, reqEditors...)
+// ParseAppSketchRemoveLibraryResp parses an HTTP response from a AppSketchRemoveLibraryWithResponse call
+func ParseAppSketchRemoveLibraryResp(rsp *http.Response) (*AppSketchRemoveLibraryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppEventsResp(rsp)
-}
 
-// GetAppLogsWithResponse request returning *GetAppLogsResp
-func (c *ClientWithResponses) GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error) {
-	rsp, err := c.GetAppLogs(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AppSketchRemoveLibraryResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAppLogsResp(rsp)
-}
 
-// StartAppWithResponse request returning *StartAppResp
-func (c *ClientWithResponses) StartAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartAppResp, error) {
-	rsp, err := c.StartApp(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseStartAppResp(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SketchRemoveLibraryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StopAppWithResponse request returning *StopAppResp
-func (c *ClientWithResponses) StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error) {
-	rsp, err := c.StopApp(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseStopAppResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetBricksWithResponse request returning *GetBricksResp
-func (c *ClientWithResponses) GetBricksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBricksResp, error) {
-	rsp, err := c.GetBricks(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBricksResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// GetBrickDetailsWithResponse request returning *GetBrickDetailsResp
-func (c *ClientWithResponses) GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error) {
-	rsp, err := c.GetBrickDetails(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBrickDetailsResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// GetConfigWithResponse request returning *GetConfigResp
-func (c *ClientWithResponses) GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error) {
-	rsp, err := c.GetConfig(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetConfigResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
-// ListLibrariesWithResponse request returning *ListLibrariesResp
-func (c *ClientWithResponses) ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error) {
-	rsp, err := c.ListLibraries(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListLibrariesResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetAIModelsWithResponse request returning *GetAIModelsResp
-func (c *ClientWithResponses) GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error) {
-	rsp, err := c.GetAIModels(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetAIModelsResp(rsp)
-}
 
-// GetAIModelDetailsWithResponse request returning *GetAIModelDetailsResp
-func (c *ClientWithResponses) GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error) {
-	rsp, err := c.GetAIModelDetails(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAIModelDetailsResp(rsp)
+	return response, nil
 }
 
-// GetPropertyKeysWithResponse request returning *GetPropertyKeysResp
-func (c *ClientWithResponses) GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error) {
-	rsp, err := c.GetPropertyKeys(ctx, reqEditors...)
+// ParseAppSketchAddLibraryResp parses an HTTP response from a AppSketchAddLibraryWithResponse call
+func ParseAppSketchAddLibraryResp(rsp *http.Response) (*AppSketchAddLibraryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPropertyKeysResp(rsp)
-}
 
-// DeletePropertyWithResponse request returning *DeletePropertyResp
-func (c *ClientWithResponses) DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error) {
-	rsp, err := c.DeleteProperty(ctx, key, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AppSketchAddLibraryResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeletePropertyResp(rsp)
-}
 
-// GetPropertyWithResponse request returning *GetPropertyResp
-func (c *ClientWithResponses) GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error) {
-	rsp, err := c.GetProperty(ctx, key, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetPropertyResp(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SketchAddLibraryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// UpdatePropertyWithBodyWithResponse request with arbitrary body returning *UpdatePropertyResp
-func (c *ClientWithResponses) UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
-	rsp, err := c.UpdatePropertyWithBody(ctx, key, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdatePropertyResp(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
-	rsp, err := c.UpdateProperty(ctx, key, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUpdatePropertyResp(rsp)
+
+	return response, nil
 }
 
-// GetSystemResourcesWithResponse request returning *GetSystemResourcesResp
-func (c *ClientWithResponses) GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error) {
-	rsp, err := c.GetSystemResources(ctx, reqEditors...)
+// ParseDeleteAppResp parses an HTTP response from a DeleteAppWithResponse call
+func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSystemResourcesResp(rsp)
-}
 
-// ApplyUpdateWithResponse request returning *ApplyUpdateResp
-func (c *ClientWithResponses) ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error) {
-	rsp, err := c.ApplyUpdate(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApplyUpdateResp(rsp)
-}
 
-// CheckUpdateWithResponse request returning *CheckUpdateResp
-func (c *ClientWithResponses) CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error) {
-	rsp, err := c.CheckUpdate(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCheckUpdateResp(rsp)
+
+	return response, nil
 }
 
-// EventsUpdateWithResponse request returning *EventsUpdateResp
-func (c *ClientWithResponses) EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error) {
-	rsp, err := c.EventsUpdate(ctx, reqEditors...)
+// ParseGetAppDetailsResp parses an HTTP response from a GetAppDetailsWithResponse call
+func ParseGetAppDetailsResp(rsp *http.Response) (*GetAppDetailsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventsUpdateResp(rsp)
-}
 
-// GetVersionsWithResponse request returning *GetVersionsResp
-func (c *ClientWithResponses) GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error) {
-	rsp, err := c.GetVersions(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppDetailsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetVersionsResp(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDetailedInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetAppsResp parses an HTTP response from a GetAppsWithResponse call
-func ParseGetAppsResp(rsp *http.Response) (*GetAppsResp, error) {
+// ParseEditAppResp parses an HTTP response from a EditAppWithResponse call
+func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppsResp{
+	response := &EditAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppListResponse
+		var dest AppDetailedInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4093,22 +7162,22 @@ func ParseGetAppsResp(rsp *http.Response) (*GetAppsResp, error) {
 	return response, nil
 }
 
-// ParseCreateAppResp parses an HTTP response from a CreateAppWithResponse call
-func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
+// ParseCloneAppResp parses an HTTP response from a CloneAppWithResponse call
+func ParseCloneAppResp(rsp *http.Response) (*CloneAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAppResp{
+	response := &CloneAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreateAppResponse
+		var dest CloneAppResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4121,6 +7190,27 @@ func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4128,6 +7218,13 @@ func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4140,20 +7237,34 @@ func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
 	return response, nil
 }
 
-// ParseGetAppsEventsResp parses an HTTP response from a GetAppsEventsWithResponse call
-func ParseGetAppsEventsResp(rsp *http.Response) (*GetAppsEventsResp, error) {
+// ParseGetAppEventsResp parses an HTTP response from a GetAppEventsWithResponse call
+func ParseGetAppEventsResp(rsp *http.Response) (*GetAppEventsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppsEventsResp{
+	response := &GetAppEventsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4166,26 +7277,40 @@ func ParseGetAppsEventsResp(rsp *http.Response) (*GetAppsEventsResp, error) {
 	return response, nil
 }
 
-// ParseGetAppBrickInstancesResp parses an HTTP response from a GetAppBrickInstancesWithResponse call
-func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesResp, error) {
+// ParseExportAppResp parses an HTTP response from a ExportAppWithResponse call
+func ParseExportAppResp(rsp *http.Response) (*ExportAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppBrickInstancesResp{
+	response := &ExportAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppBrickInstancesResult
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
@@ -4194,6 +7319,13 @@ func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesRes
 		}
 		response.JSON412 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4206,15 +7338,15 @@ func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesRes
 	return response, nil
 }
 
-// ParseDeleteAppBrickInstanceResp parses an HTTP response from a DeleteAppBrickInstanceWithResponse call
-func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanceResp, error) {
+// ParseGetAppLogsResp parses an HTTP response from a GetAppLogsWithResponse call
+func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppBrickInstanceResp{
+	response := &GetAppLogsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4227,6 +7359,20 @@ func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanc
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4246,33 +7392,155 @@ func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanc
 	return response, nil
 }
 
-// ParseGetAppBrickInstanceByBrickIDResp parses an HTTP response from a GetAppBrickInstanceByBrickIDWithResponse call
-func ParseGetAppBrickInstanceByBrickIDResp(rsp *http.Response) (*GetAppBrickInstanceByBrickIDResp, error) {
+// ParseReloadAppResp parses an HTTP response from a ReloadAppWithResponse call
+func ParseReloadAppResp(rsp *http.Response) (*ReloadAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReloadAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAppRunsResp parses an HTTP response from a GetAppRunsWithResponse call
+func ParseGetAppRunsResp(rsp *http.Response) (*GetAppRunsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppRunsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppRunsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAppSecretsResp parses an HTTP response from a GetAppSecretsWithResponse call
+func ParseGetAppSecretsResp(rsp *http.Response) (*GetAppSecretsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppBrickInstanceByBrickIDResp{
+	response := &GetAppSecretsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BrickInstance
+		var dest AppSecretNamesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
@@ -4293,15 +7561,15 @@ func ParseGetAppBrickInstanceByBrickIDResp(rsp *http.Response) (*GetAppBrickInst
 	return response, nil
 }
 
-// ParseUpdateAppBrickInstanceResp parses an HTTP response from a UpdateAppBrickInstanceWithResponse call
-func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanceResp, error) {
+// ParseDeleteAppSecretResp parses an HTTP response from a DeleteAppSecretWithResponse call
+func ParseDeleteAppSecretResp(rsp *http.Response) (*DeleteAppSecretResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAppBrickInstanceResp{
+	response := &DeleteAppSecretResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4314,6 +7582,27 @@ func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanc
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4333,15 +7622,15 @@ func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanc
 	return response, nil
 }
 
-// ParseUpsertAppBrickInstanceResp parses an HTTP response from a UpsertAppBrickInstanceWithResponse call
-func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanceResp, error) {
+// ParseUpsertAppSecretResp parses an HTTP response from a UpsertAppSecretWithResponse call
+func ParseUpsertAppSecretResp(rsp *http.Response) (*UpsertAppSecretResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpsertAppBrickInstanceResp{
+	response := &UpsertAppSecretResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4354,6 +7643,27 @@ func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanc
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4373,26 +7683,40 @@ func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanc
 	return response, nil
 }
 
-// ParseGetAppPortsResp parses an HTTP response from a GetAppPortsWithResponse call
-func ParseGetAppPortsResp(rsp *http.Response) (*GetAppPortsResp, error) {
+// ParseStartAppResp parses an HTTP response from a StartAppWithResponse call
+func ParseStartAppResp(rsp *http.Response) (*StartAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppPortsResp{
+	response := &StartAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppPortResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
@@ -4413,33 +7737,33 @@ func ParseGetAppPortsResp(rsp *http.Response) (*GetAppPortsResp, error) {
 	return response, nil
 }
 
-// ParseAppSketchListLibrariesResp parses an HTTP response from a AppSketchListLibrariesWithResponse call
-func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrariesResp, error) {
+// ParseStopAppResp parses an HTTP response from a StopAppWithResponse call
+func ParseStopAppResp(rsp *http.Response) (*StopAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppSketchListLibrariesResp{
+	response := &StopAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SketchListLibraryResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
@@ -4460,87 +7784,94 @@ func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrarie
 	return response, nil
 }
 
-// ParseAppSketchRemoveLibraryResp parses an HTTP response from a AppSketchRemoveLibraryWithResponse call
-func ParseAppSketchRemoveLibraryResp(rsp *http.Response) (*AppSketchRemoveLibraryResp, error) {
+// ParseValidateAppResp parses an HTTP response from a ValidateAppWithResponse call
+func ParseValidateAppResp(rsp *http.Response) (*ValidateAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppSketchRemoveLibraryResp{
+	response := &ValidateAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SketchRemoveLibraryResponse
+		var dest AppValidationResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAppSketchAddLibraryResp parses an HTTP response from a AppSketchAddLibraryWithResponse call
-func ParseAppSketchAddLibraryResp(rsp *http.Response) (*AppSketchAddLibraryResp, error) {
+// ParseGetBricksResp parses an HTTP response from a GetBricksWithResponse call
+func ParseGetBricksResp(rsp *http.Response) (*GetBricksResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppSketchAddLibraryResp{
+	response := &GetBricksResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SketchAddLibraryResponse
+		var dest BrickListResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4554,20 +7885,27 @@ func ParseAppSketchAddLibraryResp(rsp *http.Response) (*AppSketchAddLibraryResp,
 	return response, nil
 }
 
-// ParseDeleteAppResp parses an HTTP response from a DeleteAppWithResponse call
-func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
+// ParseGetBrickDetailsResp parses an HTTP response from a GetBrickDetailsWithResponse call
+func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppResp{
+	response := &GetBrickDetailsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrickDetailsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4575,12 +7913,26 @@ func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4594,33 +7946,40 @@ func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
 	return response, nil
 }
 
-// ParseGetAppDetailsResp parses an HTTP response from a GetAppDetailsWithResponse call
-func ParseGetAppDetailsResp(rsp *http.Response) (*GetAppDetailsResp, error) {
+// ParseGetConfigResp parses an HTTP response from a GetConfigWithResponse call
+func ParseGetConfigResp(rsp *http.Response) (*GetConfigResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppDetailsResp{
+	response := &GetConfigResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDetailedInfo
+		var dest ConfigResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4634,22 +7993,22 @@ func ParseGetAppDetailsResp(rsp *http.Response) (*GetAppDetailsResp, error) {
 	return response, nil
 }
 
-// ParseEditAppResp parses an HTTP response from a EditAppWithResponse call
-func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
+// ParseListLibrariesResp parses an HTTP response from a ListLibrariesWithResponse call
+func ParseListLibrariesResp(rsp *http.Response) (*ListLibrariesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EditAppResp{
+	response := &ListLibrariesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDetailedInfo
+		var dest LibraryListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4662,12 +8021,19 @@ func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4681,54 +8047,40 @@ func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
 	return response, nil
 }
 
-// ParseCloneAppResp parses an HTTP response from a CloneAppWithResponse call
-func ParseCloneAppResp(rsp *http.Response) (*CloneAppResp, error) {
+// ParseGetAIModelsResp parses an HTTP response from a GetAIModelsWithResponse call
+func ParseGetAIModelsResp(rsp *http.Response) (*GetAIModelsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloneAppResp{
+	response := &GetAIModelsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CloneAppResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AIModelsListResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4742,46 +8094,27 @@ func ParseCloneAppResp(rsp *http.Response) (*CloneAppResp, error) {
 	return response, nil
 }
 
-// ParseGetAppEventsResp parses an HTTP response from a GetAppEventsWithResponse call
-func ParseGetAppEventsResp(rsp *http.Response) (*GetAppEventsResp, error) {
+// ParseAddAIModelResp parses an HTTP response from a AddAIModelWithResponse call
+func ParseAddAIModelResp(rsp *http.Response) (*AddAIModelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppEventsResp{
+	response := &AddAIModelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AIModelItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetAppLogsResp parses an HTTP response from a GetAppLogsWithResponse call
-func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetAppLogsResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON201 = &dest
 
-	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4789,12 +8122,33 @@ func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4808,26 +8162,47 @@ func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
 	return response, nil
 }
 
-// ParseStartAppResp parses an HTTP response from a StartAppWithResponse call
-func ParseStartAppResp(rsp *http.Response) (*StartAppResp, error) {
+// ParseDeleteAIModelResp parses an HTTP response from a DeleteAIModelWithResponse call
+func ParseDeleteAIModelResp(rsp *http.Response) (*DeleteAIModelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StartAppResp{
+	response := &DeleteAIModelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4841,26 +8216,40 @@ func ParseStartAppResp(rsp *http.Response) (*StartAppResp, error) {
 	return response, nil
 }
 
-// ParseStopAppResp parses an HTTP response from a StopAppWithResponse call
-func ParseStopAppResp(rsp *http.Response) (*StopAppResp, error) {
+// ParseGetAIModelDetailsResp parses an HTTP response from a GetAIModelDetailsWithResponse call
+func ParseGetAIModelDetailsResp(rsp *http.Response) (*GetAIModelDetailsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StopAppResp{
+	response := &GetAIModelDetailsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AIModelItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4874,27 +8263,41 @@ func ParseStopAppResp(rsp *http.Response) (*StopAppResp, error) {
 	return response, nil
 }
 
-// ParseGetBricksResp parses an HTTP response from a GetBricksWithResponse call
-func ParseGetBricksResp(rsp *http.Response) (*GetBricksResp, error) {
+// ParseGetPropertyKeysResp parses an HTTP response from a GetPropertyKeysWithResponse call
+func ParseGetPropertyKeysResp(rsp *http.Response) (*GetPropertyKeysResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBricksResp{
+	response := &GetPropertyKeysResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BrickListResult
+		var dest PropertyKeysResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4907,26 +8310,26 @@ func ParseGetBricksResp(rsp *http.Response) (*GetBricksResp, error) {
 	return response, nil
 }
 
-// ParseGetBrickDetailsResp parses an HTTP response from a GetBrickDetailsWithResponse call
-func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error) {
+// ParseDeletePropertyResp parses an HTTP response from a DeletePropertyWithResponse call
+func ParseDeletePropertyResp(rsp *http.Response) (*DeletePropertyResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBrickDetailsResp{
+	response := &DeletePropertyResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BrickDetailsResult
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest string
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON204 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4935,6 +8338,20 @@ func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4954,26 +8371,47 @@ func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error)
 	return response, nil
 }
 
-// ParseGetConfigResp parses an HTTP response from a GetConfigWithResponse call
-func ParseGetConfigResp(rsp *http.Response) (*GetConfigResp, error) {
+// ParseGetPropertyResp parses an HTTP response from a GetPropertyWithResponse call
+func ParseGetPropertyResp(rsp *http.Response) (*GetPropertyResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigResp{
+	response := &GetPropertyResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ConfigResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4987,33 +8425,47 @@ func ParseGetConfigResp(rsp *http.Response) (*GetConfigResp, error) {
 	return response, nil
 }
 
-// ParseListLibrariesResp parses an HTTP response from a ListLibrariesWithResponse call
-func ParseListLibrariesResp(rsp *http.Response) (*ListLibrariesResp, error) {
+// ParseUpdatePropertyResp parses an HTTP response from a UpdatePropertyWithResponse call
+func ParseUpdatePropertyResp(rsp *http.Response) (*UpdatePropertyResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListLibrariesResp{
+	response := &UpdatePropertyResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LibraryListResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5027,27 +8479,41 @@ func ParseListLibrariesResp(rsp *http.Response) (*ListLibrariesResp, error) {
 	return response, nil
 }
 
-// ParseGetAIModelsResp parses an HTTP response from a GetAIModelsWithResponse call
-func ParseGetAIModelsResp(rsp *http.Response) (*GetAIModelsResp, error) {
+// ParseListAssetsResp parses an HTTP response from a ListAssetsWithResponse call
+func ParseListAssetsResp(rsp *http.Response) (*ListAssetsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAIModelsResp{
+	response := &ListAssetsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AIModelsListResult
+		var dest AssetsVersionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5060,26 +8526,33 @@ func ParseGetAIModelsResp(rsp *http.Response) (*GetAIModelsResp, error) {
 	return response, nil
 }
 
-// ParseGetAIModelDetailsResp parses an HTTP response from a GetAIModelDetailsWithResponse call
-func ParseGetAIModelDetailsResp(rsp *http.Response) (*GetAIModelDetailsResp, error) {
+// ParseUnpinAssetsResp parses an HTTP response from a UnpinAssetsWithResponse call
+func ParseUnpinAssetsResp(rsp *http.Response) (*UnpinAssetsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAIModelDetailsResp{
+	response := &UnpinAssetsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AIModelItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5093,26 +8566,47 @@ func ParseGetAIModelDetailsResp(rsp *http.Response) (*GetAIModelDetailsResp, err
 	return response, nil
 }
 
-// ParseGetPropertyKeysResp parses an HTTP response from a GetPropertyKeysWithResponse call
-func ParseGetPropertyKeysResp(rsp *http.Response) (*GetPropertyKeysResp, error) {
+// ParsePinAssetsResp parses an HTTP response from a PinAssetsWithResponse call
+func ParsePinAssetsResp(rsp *http.Response) (*PinAssetsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPropertyKeysResp{
+	response := &PinAssetsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PropertyKeysResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5126,40 +8620,40 @@ func ParseGetPropertyKeysResp(rsp *http.Response) (*GetPropertyKeysResp, error)
 	return response, nil
 }
 
-// ParseDeletePropertyResp parses an HTTP response from a DeletePropertyWithResponse call
-func ParseDeletePropertyResp(rsp *http.Response) (*DeletePropertyResp, error) {
+// ParsePruneAssetsResp parses an HTTP response from a PruneAssetsWithResponse call
+func ParsePruneAssetsResp(rsp *http.Response) (*PruneAssetsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeletePropertyResp{
+	response := &PruneAssetsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PruneResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON204 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5173,33 +8667,40 @@ func ParseDeletePropertyResp(rsp *http.Response) (*DeletePropertyResp, error) {
 	return response, nil
 }
 
-// ParseGetPropertyResp parses an HTTP response from a GetPropertyWithResponse call
-func ParseGetPropertyResp(rsp *http.Response) (*GetPropertyResp, error) {
+// ParseReloadAssetsResp parses an HTTP response from a ReloadAssetsWithResponse call
+func ParseReloadAssetsResp(rsp *http.Response) (*ReloadAssetsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPropertyResp{
+	response := &ReloadAssetsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetsReloadResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5213,20 +8714,27 @@ func ParseGetPropertyResp(rsp *http.Response) (*GetPropertyResp, error) {
 	return response, nil
 }
 
-// ParseUpdatePropertyResp parses an HTTP response from a UpdatePropertyWithResponse call
-func ParseUpdatePropertyResp(rsp *http.Response) (*UpdatePropertyResp, error) {
+// ParseListAuditResp parses an HTTP response from a ListAuditWithResponse call
+func ParseListAuditResp(rsp *http.Response) (*ListAuditResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdatePropertyResp{
+	response := &ListAuditResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5234,12 +8742,19 @@ func ParseUpdatePropertyResp(rsp *http.Response) (*UpdatePropertyResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5267,6 +8782,20 @@ func ParseGetSystemResourcesResp(rsp *http.Response) (*GetSystemResourcesResp, e
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5300,6 +8829,20 @@ func ParseApplyUpdateResp(rsp *http.Response) (*ApplyUpdateResp, error) {
 		}
 		response.JSON204 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Conflict
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5354,6 +8897,20 @@ func ParseCheckUpdateResp(rsp *http.Response) (*CheckUpdateResp, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5380,6 +8937,20 @@ func ParseEventsUpdateResp(rsp *http.Response) (*EventsUpdateResp, error) {
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
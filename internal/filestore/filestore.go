@@ -0,0 +1,115 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package filestore contains the helpers shared by the data kept in files written by both
+// the daemon and the CLI: the file locks guarding them and the validation of their keys.
+package filestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+// MaxKeyLength is the maximum length of a key.
+const MaxKeyLength = 100
+
+var keyValidationRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// ValidateKey checks that the key is not empty, not too long and made of alphanumeric
+// characters, '-', '_' and '.' only.
+func ValidateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+	if !keyValidationRegex.MatchString(key) {
+		return fmt.Errorf("key '%s' contains invalid characters; only alphanumeric, '-', '_', and '.' are allowed", key)
+	}
+	if len(key) > MaxKeyLength {
+		return fmt.Errorf("key exceeds max length of %d characters", MaxKeyLength)
+	}
+
+	return nil
+}
+
+type lockFunc func(context.Context, time.Duration) (bool, error)
+
+type UnlockFunc func() error
+
+func emptyUnlockFunc() error {
+	return nil
+}
+
+func getLock(flock *flock.Flock, lockFn lockFunc, errorMsg string) (UnlockFunc, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	locked, err := lockFn(ctx, 100*time.Millisecond)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			if err := flock.Unlock(); err != nil {
+				slog.Error("failed to unlock file lock", "path", flock.Path(), "error", err)
+			}
+			if err := os.Remove(flock.Path()); err != nil {
+				slog.Error("failed to delete lock file", "path", flock.Path(), "error", err)
+			}
+			locked = false
+			slog.Warn("lock file removed due to timeout", "path", flock.Path())
+		} else {
+			return emptyUnlockFunc, fmt.Errorf("failed trying to acquire %s for %s: %w", errorMsg, flock.Path(), err)
+		}
+	}
+	if !locked {
+		return emptyUnlockFunc, fmt.Errorf("unable to acquire %s for %s", errorMsg, flock.Path())
+	}
+
+	return func() error {
+		if err := flock.Unlock(); err != nil {
+			return fmt.Errorf("failed to unlock file lock for %s: %w", flock.Path(), err)
+		}
+		return nil
+	}, nil
+}
+
+// WriteLock takes the exclusive lock of the file, kept in a lock file next to it.
+// A lock file held for too long is considered stale and removed, so that the next
+// attempt succeeds.
+func WriteLock(filePath string) (UnlockFunc, error) {
+	fileLock := flock.New(getLockFilePath(filePath))
+	return getLock(fileLock, fileLock.TryLockContext, "write lock")
+}
+
+// ReadLock takes the shared lock of the file, kept in a lock file next to it.
+func ReadLock(filePath string) (UnlockFunc, error) {
+	fileLock := flock.New(getLockFilePath(filePath))
+	return getLock(fileLock, fileLock.TryRLockContext, "read lock")
+}
+
+// Release releases the lock of the file, logging the failures.
+func Release(filePath string, unlock UnlockFunc) {
+	if err := unlock(); err != nil {
+		slog.Error("failed to release lock", "file", filePath, "error", err)
+	}
+}
+
+func getLockFilePath(path string) string {
+	return path + ".lock"
+}
@@ -13,7 +13,7 @@
 // Arduino software without disclosing the source code of your own applications.
 // To purchase a commercial license, send an email to license@arduino.cc.
 
-package properties
+package filestore
 
 import (
 	"strings"
@@ -45,7 +45,7 @@ func TestValidateKey(t *testing.T) {
 		},
 		{
 			name:        "key at max length",
-			input:       strings.Repeat("a", maxKeyLength),
+			input:       strings.Repeat("a", MaxKeyLength),
 			expectError: false,
 		},
 		{
@@ -55,7 +55,7 @@ func TestValidateKey(t *testing.T) {
 		},
 		{
 			name:        "key too long",
-			input:       strings.Repeat("a", maxKeyLength+1),
+			input:       strings.Repeat("a", MaxKeyLength+1),
 			expectError: true,
 		},
 		{
@@ -76,7 +76,7 @@ func TestValidateKey(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := validateKey(tc.input)
+			err := ValidateKey(tc.input)
 
 			if tc.expectError && err == nil {
 				require.Error(t, err, "expected an error but got none")
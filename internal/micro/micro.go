@@ -16,12 +16,15 @@
 package micro
 
 import (
+	"context"
 	"time"
 )
 
 const (
 	ResetPin = 38
 	ChipName = "gpiochip1"
+	// Consumer is the label of the requests of the reset line made by this program.
+	Consumer = "arduino-app-cli"
 )
 
 func Reset() error {
@@ -42,3 +45,17 @@ func Enable() error {
 func Disable() error {
 	return disableOnBoard()
 }
+
+// IsEnabled reports whether the reset line of the micro is released, i.e. the
+// micro is running the loaded sketch. The line is inspected without requesting it.
+func IsEnabled() (bool, error) {
+	return isEnabledOnBoard()
+}
+
+// WatchReset calls onReset every time the reset line of the micro is released
+// by someone else, i.e. after the micro has been reset or flashed. The releases
+// caused by Enable and Disable are ignored.
+// The watch is stopped when the context is done.
+func WatchReset(ctx context.Context, onReset func()) error {
+	return watchResetOnBoard(ctx, onReset)
+}
@@ -18,7 +18,10 @@
 
 package micro
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 func enableOnBoard() error {
 	return fmt.Errorf("micro is not supported on this platform")
@@ -27,3 +30,11 @@ func enableOnBoard() error {
 func disableOnBoard() error {
 	return fmt.Errorf("Enable is not supported on this platform")
 }
+
+func isEnabledOnBoard() (bool, error) {
+	return false, fmt.Errorf("reading the micro state is not supported on this platform")
+}
+
+func watchResetOnBoard(_ context.Context, _ func()) error {
+	return fmt.Errorf("watching the micro reset is not supported on this platform")
+}
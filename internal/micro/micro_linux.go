@@ -19,11 +19,13 @@
 package micro
 
 import (
+	"context"
+
 	"github.com/warthog618/go-gpiocdev"
 )
 
 func enableOnBoard() error {
-	chip, err := gpiocdev.NewChip(ChipName)
+	chip, err := gpiocdev.NewChip(ChipName, gpiocdev.WithConsumer(Consumer))
 	if err != nil {
 		return err
 	}
@@ -39,7 +41,7 @@ func enableOnBoard() error {
 }
 
 func disableOnBoard() error {
-	chip, err := gpiocdev.NewChip(ChipName)
+	chip, err := gpiocdev.NewChip(ChipName, gpiocdev.WithConsumer(Consumer))
 	if err != nil {
 		return err
 	}
@@ -53,3 +55,50 @@ func disableOnBoard() error {
 
 	return line.SetValue(0)
 }
+
+func isEnabledOnBoard() (bool, error) {
+	chip, err := gpiocdev.NewChip(ChipName)
+	if err != nil {
+		return false, err
+	}
+	defer chip.Close()
+
+	// The line is not requested, since every release of the line is seen as a reset
+	// by the watchers. The reset line is held only while the micro is reset or flashed.
+	info, err := chip.LineInfo(ResetPin)
+	if err != nil {
+		return false, err
+	}
+	return !info.Used || info.Consumer == Consumer, nil
+}
+
+func watchResetOnBoard(ctx context.Context, onReset func()) error {
+	chip, err := gpiocdev.NewChip(ChipName)
+	if err != nil {
+		return err
+	}
+
+	// The releases following a request of this program, to enable or disable the micro,
+	// are not resets.
+	var ownRequest bool
+	if _, err := chip.WatchLineInfo(ResetPin, func(evt gpiocdev.LineInfoChangeEvent) {
+		switch evt.Type {
+		case gpiocdev.LineRequested:
+			ownRequest = evt.Info.Consumer == Consumer
+		case gpiocdev.LineReleased:
+			if !ownRequest {
+				onReset()
+			}
+			ownRequest = false
+		}
+	}); err != nil {
+		chip.Close()
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		chip.Close()
+	}()
+	return nil
+}
@@ -0,0 +1,162 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Address is the TCP endpoint exposed by the router to talk with the
+// serial monitor of the MCU.
+const Address = "127.0.0.1:7500"
+
+const dialTimeout = time.Second
+
+// Dial opens a connection to the serial monitor.
+func Dial() (net.Conn, error) {
+	return net.DialTimeout("tcp", Address, dialTimeout)
+}
+
+type LineEnding string
+
+const (
+	LineEndingNone LineEnding = "none"
+	LineEndingNL   LineEnding = "nl"
+	LineEndingCR   LineEnding = "cr"
+	LineEndingCRNL LineEnding = "crnl"
+)
+
+func ParseLineEnding(s string) (LineEnding, error) {
+	switch l := LineEnding(strings.ToLower(s)); l {
+	case LineEndingNone, LineEndingNL, LineEndingCR, LineEndingCRNL:
+		return l, nil
+	}
+	return "", fmt.Errorf("invalid line ending %q: must be one of none, nl, cr, crnl", s)
+}
+
+func (l LineEnding) Bytes() []byte {
+	switch l {
+	case LineEndingNL:
+		return []byte("\n")
+	case LineEndingCR:
+		return []byte("\r")
+	case LineEndingCRNL:
+		return []byte("\r\n")
+	default:
+		return nil
+	}
+}
+
+type Encoding string
+
+const (
+	EncodingASCII Encoding = "ascii"
+	EncodingHex   Encoding = "hex"
+)
+
+func ParseEncoding(s string) (Encoding, error) {
+	switch e := Encoding(strings.ToLower(s)); e {
+	case EncodingASCII, EncodingHex:
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid encoding %q: must be one of ascii, hex", s)
+}
+
+const timestampLayout = "15:04:05.000"
+
+// OutputWriter formats the data coming from the monitor according to the
+// selected encoding, optionally prefixing every line with a timestamp.
+type OutputWriter struct {
+	w           io.Writer
+	encoding    Encoding
+	timestamps  bool
+	now         func() time.Time
+	atLineStart bool
+}
+
+func NewOutputWriter(w io.Writer, encoding Encoding, timestamps bool) *OutputWriter {
+	return &OutputWriter{
+		w:           w,
+		encoding:    encoding,
+		timestamps:  timestamps,
+		now:         time.Now,
+		atLineStart: true,
+	}
+}
+
+func (o *OutputWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var b strings.Builder
+	switch o.encoding {
+	case EncodingHex:
+		// Every chunk received from the monitor is printed on its own line.
+		if o.timestamps {
+			b.WriteString(o.timestamp())
+		}
+		for i, c := range p {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprintf(&b, "%02X", c)
+		}
+		b.WriteByte('\n')
+	default:
+		if !o.timestamps {
+			if _, err := o.w.Write(p); err != nil {
+				return 0, err
+			}
+			return len(p), nil
+		}
+		for _, c := range p {
+			if o.atLineStart {
+				b.WriteString(o.timestamp())
+			}
+			b.WriteByte(c)
+			o.atLineStart = c == '\n'
+		}
+	}
+
+	if _, err := io.WriteString(o.w, b.String()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (o *OutputWriter) timestamp() string {
+	return "[" + o.now().Format(timestampLayout) + "] "
+}
+
+// ForwardInput reads src line by line and writes every line to dst
+// terminated with the given line ending.
+func ForwardInput(dst io.Writer, src io.Reader, ending LineEnding) error {
+	scanner := bufio.NewScanner(src)
+	for scanner.Scan() {
+		line := slices.Concat(scanner.Bytes(), ending.Bytes())
+		if _, err := dst.Write(line); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
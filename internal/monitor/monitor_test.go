@@ -0,0 +1,105 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLineEnding(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		expected []byte
+	}{
+		{"none", nil},
+		{"nl", []byte("\n")},
+		{"CR", []byte("\r")},
+		{"crnl", []byte("\r\n")},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			l, err := ParseLineEnding(tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, l.Bytes())
+		})
+	}
+
+	_, err := ParseLineEnding("lf")
+	require.Error(t, err)
+}
+
+func TestOutputWriter(t *testing.T) {
+	fixedNow := func() time.Time { return time.Date(2025, 1, 1, 10, 20, 30, 400_000_000, time.UTC) }
+
+	testCases := []struct {
+		name       string
+		encoding   Encoding
+		timestamps bool
+		chunks     []string
+		expected   string
+	}{
+		{
+			name:     "ascii passthrough",
+			encoding: EncodingASCII,
+			chunks:   []string{"hel", "lo\n"},
+			expected: "hello\n",
+		},
+		{
+			name:       "ascii with timestamps across chunks",
+			encoding:   EncodingASCII,
+			timestamps: true,
+			chunks:     []string{"hel", "lo\nwor", "ld\n"},
+			expected:   "[10:20:30.400] hello\n[10:20:30.400] world\n",
+		},
+		{
+			name:     "hex",
+			encoding: EncodingHex,
+			chunks:   []string{"AB\n"},
+			expected: "41 42 0A\n",
+		},
+		{
+			name:       "hex with timestamps",
+			encoding:   EncodingHex,
+			timestamps: true,
+			chunks:     []string{"A", "B"},
+			expected:   "[10:20:30.400] 41\n[10:20:30.400] 42\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewOutputWriter(&buf, tc.encoding, tc.timestamps)
+			w.now = fixedNow
+			for _, c := range tc.chunks {
+				n, err := w.Write([]byte(c))
+				require.NoError(t, err)
+				require.Equal(t, len(c), n)
+			}
+			require.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestForwardInput(t *testing.T) {
+	var buf bytes.Buffer
+	err := ForwardInput(&buf, strings.NewReader("first\nsecond\n"), LineEndingCRNL)
+	require.NoError(t, err)
+	require.Equal(t, "first\r\nsecond\r\n", buf.String())
+}
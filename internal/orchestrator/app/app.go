@@ -137,6 +137,11 @@ func (a *ArduinoApp) SketchBuildPath() *paths.Path {
 	return a.FullPath.Join(".cache", "sketch")
 }
 
+// SketchBuildCacheFilePath is the file describing the last successful build of the sketch.
+func (a *ArduinoApp) SketchBuildCacheFilePath() *paths.Path {
+	return a.ProvisioningStateDir().Join("sketch-build.json")
+}
+
 func (a *ArduinoApp) ProvisioningStateDir() *paths.Path {
 	return a.FullPath.Join(".cache")
 }
@@ -148,3 +153,11 @@ func (a *ArduinoApp) AppComposeFilePath() *paths.Path {
 func (a *ArduinoApp) AppComposeOverrideFilePath() *paths.Path {
 	return a.ProvisioningStateDir().Join("app-compose-overrides.yaml")
 }
+
+func (a *ArduinoApp) RestartStateFilePath() *paths.Path {
+	return a.ProvisioningStateDir().Join("restart-state.json")
+}
+
+func (a *ArduinoApp) RunsFilePath() *paths.Path {
+	return a.ProvisioningStateDir().Join("runs.json")
+}
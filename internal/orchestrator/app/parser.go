@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"time"
 
 	emoji "github.com/Andrew-M-C/go.emoji"
 	"github.com/arduino/go-paths-helper"
@@ -32,13 +34,113 @@ type Brick struct {
 	Variables map[string]string `yaml:"variables,omitempty"`
 }
 
+type RestartPolicyMode string
+
+const (
+	RestartNever     RestartPolicyMode = "never"
+	RestartOnFailure RestartPolicyMode = "on-failure"
+	RestartAlways    RestartPolicyMode = "always"
+)
+
+var restartPolicyModes = []RestartPolicyMode{RestartNever, RestartOnFailure, RestartAlways}
+
+const (
+	DefaultRestartBackoff = 5 * time.Second
+	MaxRestartBackoff     = 5 * time.Minute
+)
+
+// RestartPolicy defines how the daemon recovers the containers of the app when they die.
+// MaxRetries limits the consecutive restarts (0 means unlimited), the Backoff delay
+// is doubled at each consecutive restart up to MaxRestartBackoff.
+type RestartPolicy struct {
+	Mode       RestartPolicyMode `yaml:"mode"`
+	MaxRetries int               `yaml:"max_retries,omitempty"`
+	Backoff    string            `yaml:"backoff,omitempty"`
+}
+
+func (p *RestartPolicy) IsValid() error {
+	if !slices.Contains(restartPolicyModes, p.Mode) {
+		return fmt.Errorf("invalid restart policy mode %q, allowed modes are: %v", p.Mode, restartPolicyModes)
+	}
+	if p.MaxRetries < 0 {
+		return fmt.Errorf("restart policy max_retries cannot be negative")
+	}
+	if p.Backoff != "" {
+		if d, err := time.ParseDuration(p.Backoff); err != nil || d <= 0 {
+			return fmt.Errorf("invalid restart policy backoff %q, expected a positive duration (e.g. 5s, 1m)", p.Backoff)
+		}
+	}
+	return nil
+}
+
+// BackoffDelay returns the delay to wait before the restart following the given
+// number of consecutive restarts.
+func (p *RestartPolicy) BackoffDelay(retries int) time.Duration {
+	delay := DefaultRestartBackoff
+	if d, err := time.ParseDuration(p.Backoff); err == nil && d > 0 {
+		delay = d
+	}
+	for range retries {
+		delay *= 2
+		if delay >= MaxRestartBackoff {
+			return MaxRestartBackoff
+		}
+	}
+	return delay
+}
+
+type SketchDeployMode string
+
+const (
+	// SketchDeployRAM uploads the sketch in the RAM of the micro, the sketch is lost
+	// when the micro is reset or powered off.
+	SketchDeployRAM SketchDeployMode = "ram"
+	// SketchDeployFlash burns the sketch in the flash of the micro, the sketch runs
+	// again after a power cycle.
+	SketchDeployFlash SketchDeployMode = "flash"
+)
+
+var sketchDeployModes = []SketchDeployMode{SketchDeployRAM, SketchDeployFlash}
+
+// SketchSettings configures how the sketch of the app is handled.
+type SketchSettings struct {
+	Deploy SketchDeployMode `yaml:"deploy,omitempty"`
+}
+
+func (s *SketchSettings) IsValid() error {
+	if s.Deploy != "" && !slices.Contains(sketchDeployModes, s.Deploy) {
+		return fmt.Errorf("invalid sketch deploy mode %q, allowed modes are: %v", s.Deploy, sketchDeployModes)
+	}
+	return nil
+}
+
 type AppDescriptor struct {
-	Name            string   `yaml:"name"`
-	Description     string   `yaml:"description"`
-	Ports           []int    `yaml:"ports"`
-	Bricks          []Brick  `yaml:"bricks"`
-	Icon            string   `yaml:"icon,omitempty"`
-	RequiredDevices []string `yaml:"required_devices,omitempty"`
+	Name            string          `yaml:"name"`
+	Description     string          `yaml:"description"`
+	Ports           []int           `yaml:"ports"`
+	Bricks          []Brick         `yaml:"bricks"`
+	Icon            string          `yaml:"icon,omitempty"`
+	RequiredDevices []string        `yaml:"required_devices,omitempty"`
+	RestartPolicy   *RestartPolicy  `yaml:"restart_policy,omitempty"`
+	Sketch          *SketchSettings `yaml:"sketch,omitempty"`
+}
+
+// GetRestartPolicy returns the restart policy of the app, apps without
+// a restart_policy are never restarted.
+func (d AppDescriptor) GetRestartPolicy() RestartPolicy {
+	if d.RestartPolicy == nil {
+		return RestartPolicy{Mode: RestartNever}
+	}
+	return *d.RestartPolicy
+}
+
+// GetSketchDeployMode returns where the sketch of the app is uploaded, by default
+// the sketch is uploaded in RAM.
+func (d AppDescriptor) GetSketchDeployMode() SketchDeployMode {
+	if d.Sketch == nil || d.Sketch.Deploy == "" {
+		return SketchDeployRAM
+	}
+	return d.Sketch.Deploy
 }
 
 func (d AppDescriptor) MarshalYAML() (any, error) {
@@ -49,6 +151,8 @@ func (d AppDescriptor) MarshalYAML() (any, error) {
 		Bricks          []map[string]Brick `yaml:"bricks"`
 		Icon            string             `yaml:"icon,omitempty"`
 		RequiredDevices []string           `yaml:"required_devices,omitempty"`
+		RestartPolicy   *RestartPolicy     `yaml:"restart_policy,omitempty"`
+		Sketch          *SketchSettings    `yaml:"sketch,omitempty"`
 	}
 
 	bricks := make([]map[string]Brick, len(d.Bricks))
@@ -62,6 +166,8 @@ func (d AppDescriptor) MarshalYAML() (any, error) {
 		Bricks:          bricks,
 		Icon:            d.Icon,
 		RequiredDevices: d.RequiredDevices,
+		RestartPolicy:   d.RestartPolicy,
+		Sketch:          d.Sketch,
 	}, nil
 }
 
@@ -135,6 +241,12 @@ func (a *AppDescriptor) IsValid() error {
 			allErrors = errors.Join(allErrors, fmt.Errorf("icon %q is not a valid single emoji", a.Icon))
 		}
 	}
+	if a.RestartPolicy != nil {
+		allErrors = errors.Join(allErrors, a.RestartPolicy.IsValid())
+	}
+	if a.Sketch != nil {
+		allErrors = errors.Join(allErrors, a.Sketch.IsValid())
+	}
 	return allErrors
 }
 
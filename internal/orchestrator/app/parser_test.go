@@ -18,8 +18,10 @@ package app
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/require"
 )
 
@@ -123,3 +125,64 @@ bricks:
 	require.Equal(t, "yolox-object-detection", app.Descriptor.Bricks[0].Model)
 	require.Equal(t, "/home/arduino/.arduino-bricks/ei-models/face-det.eim", app.Descriptor.Bricks[0].Variables["EI_OBJ_DETECTION_MODEL"])
 }
+
+func TestRestartPolicy(t *testing.T) {
+	parse := func(t *testing.T, content string) (AppDescriptor, error) {
+		appYaml := paths.New(t.TempDir(), "app.yaml")
+		require.NoError(t, appYaml.WriteFile([]byte(content)))
+		return ParseDescriptorFile(appYaml)
+	}
+
+	t.Run("default is never", func(t *testing.T) {
+		desc, err := parse(t, "name: app\n")
+		require.NoError(t, err)
+		require.Equal(t, RestartPolicy{Mode: RestartNever}, desc.GetRestartPolicy())
+	})
+
+	t.Run("on-failure", func(t *testing.T) {
+		desc, err := parse(t, "name: app\nrestart_policy:\n  mode: on-failure\n  max_retries: 3\n  backoff: 2s\n")
+		require.NoError(t, err)
+		policy := desc.GetRestartPolicy()
+		require.Equal(t, RestartPolicy{Mode: RestartOnFailure, MaxRetries: 3, Backoff: "2s"}, policy)
+		require.Equal(t, 2*time.Second, policy.BackoffDelay(0))
+		require.Equal(t, 8*time.Second, policy.BackoffDelay(2))
+		require.Equal(t, MaxRestartBackoff, policy.BackoffDelay(20))
+	})
+
+	t.Run("default backoff", func(t *testing.T) {
+		policy := RestartPolicy{Mode: RestartAlways}
+		require.Equal(t, DefaultRestartBackoff, policy.BackoffDelay(0))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parse(t, "name: app\nrestart_policy:\n  mode: sometimes\n")
+		require.ErrorContains(t, err, `invalid restart policy mode "sometimes"`)
+		_, err = parse(t, "name: app\nrestart_policy:\n  mode: always\n  backoff: soon\n")
+		require.ErrorContains(t, err, `invalid restart policy backoff "soon"`)
+		_, err = parse(t, "name: app\nrestart_policy:\n  mode: on-failure\n  max_retries: -1\n")
+		require.ErrorContains(t, err, "max_retries cannot be negative")
+	})
+}
+
+func TestSketchDeployMode(t *testing.T) {
+	parse := func(t *testing.T, content string) (AppDescriptor, error) {
+		appYaml := paths.New(t.TempDir(), "app.yaml")
+		require.NoError(t, appYaml.WriteFile([]byte(content)))
+		return ParseDescriptorFile(appYaml)
+	}
+
+	desc, err := parse(t, "name: app\n")
+	require.NoError(t, err)
+	require.Equal(t, SketchDeployRAM, desc.GetSketchDeployMode())
+
+	desc, err = parse(t, "name: app\nsketch:\n  deploy: flash\n")
+	require.NoError(t, err)
+	require.Equal(t, SketchDeployFlash, desc.GetSketchDeployMode())
+
+	out, err := yaml.Marshal(desc)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "sketch:\n  deploy: flash\n")
+
+	_, err = parse(t, "name: app\nsketch:\n  deploy: eeprom\n")
+	require.ErrorContains(t, err, `invalid sketch deploy mode "eeprom"`)
+}
@@ -0,0 +1,381 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
+	"github.com/gosimple/slug"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+// AppArchiveFormatVersion is the version of the archive layout produced by ExportApp.
+const AppArchiveFormatVersion = 1
+
+const appArchiveManifestName = "manifest.yaml"
+
+// MaxAppArchiveSize is the maximum size of an app archive, an exported archive larger
+// than that could not be imported.
+const MaxAppArchiveSize = 512 * 1024 * 1024
+
+// maxAppArchiveSize is the maximum size of an exported app archive, it is changed by the tests.
+var maxAppArchiveSize int64 = MaxAppArchiveSize
+
+// maxAppArchiveExtractedSize is the maximum size of the files extracted from an app archive.
+var maxAppArchiveExtractedSize int64 = 2 * 1024 * 1024 * 1024
+
+var (
+	ErrInvalidAppArchive  = errors.New("invalid app archive")
+	ErrAppArchiveTooLarge = errors.New("app archive too large")
+)
+
+// AppArchiveManifest describes the content of an exported app and what it
+// needs to run on the target board.
+type AppArchiveManifest struct {
+	FormatVersion int       `yaml:"format_version" json:"format_version"`
+	Name          string    `yaml:"name" json:"name"`
+	RunnerVersion string    `yaml:"runner_version" json:"runner_version"`
+	CreatedAt     time.Time `yaml:"created_at" json:"created_at"`
+	Bricks        []string  `yaml:"bricks,omitempty" json:"bricks,omitempty"`
+	Models        []string  `yaml:"models,omitempty" json:"models,omitempty"`
+	IncludesData  bool      `yaml:"includes_data" json:"includes_data"`
+}
+
+type ExportAppRequest struct {
+	IncludeData bool
+}
+
+// ExportApp writes a gzipped tar archive of the app to w. The archive
+// contains a manifest followed by the app files, the .cache folder is never
+// exported while the data folder is exported only on request. It fails with
+// ErrAppArchiveTooLarge if the archive exceeds MaxAppArchiveSize, in that
+// case w contains a truncated archive.
+func ExportApp(
+	ctx context.Context,
+	w io.Writer,
+	userApp app.ArduinoApp,
+	req ExportAppRequest,
+	bricksIndex *bricksindex.BricksIndex,
+	cfg config.Configuration,
+) error {
+	manifest := AppArchiveManifest{
+		FormatVersion: AppArchiveFormatVersion,
+		Name:          userApp.Name,
+		RunnerVersion: cfg.RunnerVersion,
+		CreatedAt:     time.Now().UTC(),
+		IncludesData:  req.IncludeData && userApp.FullPath.Join("data").IsDir(),
+	}
+	for _, brick := range userApp.Descriptor.Bricks {
+		manifest.Bricks = append(manifest.Bricks, brick.ID)
+		modelID := brick.Model
+		if modelID == "" {
+			if idxBrick, found := bricksIndex.FindBrickByID(brick.ID); found {
+				modelID = idxBrick.ModelName
+			}
+		}
+		if modelID != "" && !slices.Contains(manifest.Models, modelID) {
+			manifest.Models = append(manifest.Models, modelID)
+		}
+	}
+	manifestContent, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	gw := gzip.NewWriter(&appArchiveWriter{w: w, remaining: maxAppArchiveSize})
+	tw := tar.NewWriter(gw)
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     appArchiveManifestName,
+		Mode:     0644,
+		Size:     int64(len(manifestContent)),
+		ModTime:  manifest.CreatedAt,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(manifestContent); err != nil {
+		return err
+	}
+
+	excluded := []string{".cache"}
+	if !manifest.IncludesData {
+		excluded = append(excluded, "data")
+	}
+	root := userApp.FullPath.String()
+	err = fs.WalkDir(os.DirFS(root), ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if name == "." {
+			return nil
+		}
+		if top, _, _ := strings.Cut(name, "/"); slices.Contains(excluded, top) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		// Only regular files and directories are exported.
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join("app", name)
+		if d.IsDir() {
+			hdr.Name += "/"
+		}
+		hdr.Uname, hdr.Gname, hdr.Uid, hdr.Gid = "", "", 0, 0
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		f, err := os.Open(paths.New(root, name).String())
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export app: %w", err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// appArchiveWriter fails as soon as the written archive exceeds the maximum size.
+type appArchiveWriter struct {
+	w         io.Writer
+	remaining int64
+}
+
+func (a *appArchiveWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > a.remaining {
+		return 0, fmt.Errorf("%w: the archive exceeds %d bytes", ErrAppArchiveTooLarge, maxAppArchiveSize)
+	}
+	n, err := a.w.Write(p)
+	a.remaining -= int64(n)
+	return n, err
+}
+
+type ImportAppRequest struct {
+	// Name overrides the name of the imported app.
+	Name *string
+}
+
+type ImportAppResponse struct {
+	ID       app.ID             `json:"id" required:"true"`
+	Manifest AppArchiveManifest `json:"manifest" required:"true"`
+	// MissingBricks are the bricks used by the app that are not available on this board.
+	MissingBricks []string `json:"missing_bricks,omitempty"`
+	// MissingModels are the models used by the app that are not available on this board.
+	MissingModels []string `json:"missing_models,omitempty"`
+	// RunnerVersionMismatch is set when the app was exported with a different runner version.
+	RunnerVersionMismatch bool `json:"runner_version_mismatch"`
+}
+
+// ImportApp extracts an archive produced by ExportApp in the apps directory
+// and reports the bricks and models that are not available locally.
+func ImportApp(
+	ctx context.Context,
+	r io.Reader,
+	req ImportAppRequest,
+	idProvider *app.IDProvider,
+	bricksIndex *bricksindex.BricksIndex,
+	modelsIndex *modelsindex.ModelsIndex,
+	cfg config.Configuration,
+) (response ImportAppResponse, importErr error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: %w", ErrInvalidAppArchive, err)
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	// The manifest is always the first entry of the archive.
+	hdr, err := tr.Next()
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: %w", ErrInvalidAppArchive, err)
+	}
+	if hdr.Name != appArchiveManifestName {
+		return ImportAppResponse{}, fmt.Errorf("%w: missing manifest", ErrInvalidAppArchive)
+	}
+	if hdr.Size > 1024*1024 {
+		return ImportAppResponse{}, fmt.Errorf("%w: manifest too large", ErrInvalidAppArchive)
+	}
+	var manifest AppArchiveManifest
+	if err := yaml.NewDecoder(tr).Decode(&manifest); err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: invalid manifest: %w", ErrInvalidAppArchive, err)
+	}
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > AppArchiveFormatVersion {
+		return ImportAppResponse{}, fmt.Errorf("%w: unsupported format version %d", ErrInvalidAppArchive, manifest.FormatVersion)
+	}
+
+	name := manifest.Name
+	if req.Name != nil && *req.Name != "" {
+		name = *req.Name
+	}
+	if name == "" {
+		return ImportAppResponse{}, fmt.Errorf("%w: missing app name", ErrInvalidAppArchive)
+	}
+	dstPath := cfg.AppsDir().Join(slug.Make(name))
+	if dstPath.Exist() {
+		return ImportAppResponse{}, ErrAppAlreadyExists
+	}
+	if err := dstPath.MkdirAll(); err != nil {
+		return ImportAppResponse{}, fmt.Errorf("failed to create app directory: %w", err)
+	}
+	// In case something during the import fails we remove the dst path
+	defer func() {
+		if importErr != nil {
+			_ = dstPath.RemoveAll()
+		}
+	}()
+
+	remaining := maxAppArchiveExtractedSize
+	for {
+		if ctx.Err() != nil {
+			return ImportAppResponse{}, ctx.Err()
+		}
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return ImportAppResponse{}, fmt.Errorf("%w: %w", ErrInvalidAppArchive, err)
+		}
+
+		rel, ok := strings.CutPrefix(path.Clean(hdr.Name), "app/")
+		if !ok || !fs.ValidPath(rel) {
+			return ImportAppResponse{}, fmt.Errorf("%w: invalid entry %q", ErrInvalidAppArchive, hdr.Name)
+		}
+		if top, _, _ := strings.Cut(rel, "/"); top == ".cache" {
+			continue
+		}
+		target := dstPath.Join(rel)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := target.MkdirAll(); err != nil {
+				return ImportAppResponse{}, err
+			}
+		case tar.TypeReg:
+			if err := target.Parent().MkdirAll(); err != nil {
+				return ImportAppResponse{}, err
+			}
+			f, err := os.OpenFile(target.String(), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, hdr.FileInfo().Mode().Perm()|0600)
+			if err != nil {
+				return ImportAppResponse{}, err
+			}
+			// Read one byte more than allowed, to detect the archives that are too large.
+			n, err := io.Copy(f, io.LimitReader(tr, remaining+1))
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return ImportAppResponse{}, fmt.Errorf("failed to extract %q: %w", hdr.Name, err)
+			}
+			if remaining -= n; remaining < 0 {
+				return ImportAppResponse{}, fmt.Errorf("%w: the extracted files exceed %d bytes", ErrAppArchiveTooLarge, maxAppArchiveExtractedSize)
+			}
+		default:
+			return ImportAppResponse{}, fmt.Errorf("%w: unsupported entry type for %q", ErrInvalidAppArchive, hdr.Name)
+		}
+	}
+
+	importedApp, err := app.Load(dstPath.String())
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: %w", ErrInvalidAppArchive, err)
+	}
+	if req.Name != nil && *req.Name != "" && importedApp.Descriptor.Name != *req.Name {
+		importedApp.Descriptor.Name = *req.Name
+		if err := importedApp.Save(); err != nil {
+			return ImportAppResponse{}, fmt.Errorf("failed to rename app: %w", err)
+		}
+	}
+
+	response.Manifest = manifest
+	response.RunnerVersionMismatch = manifest.RunnerVersion != cfg.RunnerVersion
+	response.MissingBricks, response.MissingModels = findMissingAppDependencies(importedApp, manifest, bricksIndex, modelsIndex)
+
+	id, err := idProvider.IDFromPath(dstPath)
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("failed to get app id: %w", err)
+	}
+	response.ID = id
+	return response, nil
+}
+
+// findMissingAppDependencies returns the bricks and models used by the app
+// that are not present in the local indexes. The models listed by the manifest
+// include the default ones of the bricks on the exporting board.
+func findMissingAppDependencies(
+	a app.ArduinoApp,
+	manifest AppArchiveManifest,
+	bricksIndex *bricksindex.BricksIndex,
+	modelsIndex *modelsindex.ModelsIndex,
+) (missingBricks []string, missingModels []string) {
+	modelIDs := slices.Clone(manifest.Models)
+	for _, brick := range a.Descriptor.Bricks {
+		if _, found := bricksIndex.FindBrickByID(brick.ID); !found {
+			missingBricks = append(missingBricks, brick.ID)
+		}
+		if brick.Model != "" {
+			modelIDs = append(modelIDs, brick.Model)
+		}
+	}
+	for _, modelID := range modelIDs {
+		if _, found := modelsIndex.GetModelByID(modelID); !found && !slices.Contains(missingModels, modelID) {
+			missingModels = append(missingModels, modelID)
+		}
+	}
+	return missingBricks, missingModels
+}
@@ -0,0 +1,174 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func TestExportImportApp(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	require.NoError(t, cfg.AssetsDir().Join("bricks-list.yaml").WriteFile([]byte(`
+bricks:
+- id: arduino:object_detection
+  name: Object Detection
+  require_model: true
+  model_name: yolox-object-detection
+`)))
+	require.NoError(t, cfg.AssetsDir().Join("models-list.yaml").WriteFile([]byte(`
+models:
+- yolox-object-detection:
+    runner: brick
+    name: "YoloX"
+    bricks:
+    - arduino:object_detection
+`)))
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+
+	appID := createApp(t, "exported", false, idProvider, cfg)
+	exported := f.Must(app.Load(appID.ToPath().String()))
+	exported.Descriptor.Bricks = []app.Brick{
+		{ID: "arduino:object_detection"},
+		{ID: "custom:missing", Model: "missing-model"},
+	}
+	require.NoError(t, exported.Save())
+	require.NoError(t, exported.FullPath.Join("data").MkdirAll())
+	require.NoError(t, exported.FullPath.Join("data", "db.txt").WriteFile([]byte("data")))
+	require.NoError(t, exported.ProvisioningStateDir().MkdirAll())
+	require.NoError(t, exported.ProvisioningStateDir().Join("state").WriteFile([]byte("cache")))
+
+	export := func(t *testing.T, includeData bool) []byte {
+		var buf bytes.Buffer
+		require.NoError(t, ExportApp(t.Context(), &buf, exported, ExportAppRequest{IncludeData: includeData}, bricksIndex, cfg))
+		return buf.Bytes()
+	}
+
+	t.Run("export content", func(t *testing.T) {
+		archive := export(t, false)
+		gr, err := gzip.NewReader(bytes.NewReader(archive))
+		require.NoError(t, err)
+		tr := tar.NewReader(gr)
+		var names []string
+		for {
+			hdr, err := tr.Next()
+			if err != nil {
+				break
+			}
+			names = append(names, hdr.Name)
+		}
+		require.Equal(t, "manifest.yaml", names[0])
+		require.Contains(t, names, "app/app.yaml")
+		require.Contains(t, names, "app/python/main.py")
+		require.Contains(t, names, "app/sketch/sketch.ino")
+		require.NotContains(t, names, "app/data/db.txt")
+		require.NotContains(t, names, "app/.cache/state")
+	})
+
+	t.Run("import with missing dependencies", func(t *testing.T) {
+		res, err := ImportApp(t.Context(), bytes.NewReader(export(t, true)), ImportAppRequest{Name: f.Ptr("imported")}, idProvider, bricksIndex, modelsIndex, cfg)
+		require.NoError(t, err)
+		require.Equal(t, f.Must(idProvider.ParseID("user:imported")), res.ID)
+		require.Equal(t, AppArchiveFormatVersion, res.Manifest.FormatVersion)
+		require.Equal(t, []string{"arduino:object_detection", "custom:missing"}, res.Manifest.Bricks)
+		require.Equal(t, []string{"yolox-object-detection", "missing-model"}, res.Manifest.Models)
+		require.True(t, res.Manifest.IncludesData)
+		require.False(t, res.RunnerVersionMismatch)
+		require.Equal(t, []string{"custom:missing"}, res.MissingBricks)
+		require.Equal(t, []string{"missing-model"}, res.MissingModels)
+
+		imported := f.Must(app.Load(res.ID.ToPath().String()))
+		require.Equal(t, "imported", imported.Descriptor.Name)
+		require.FileExists(t, imported.FullPath.Join("data", "db.txt").String())
+		require.NoFileExists(t, imported.ProvisioningStateDir().Join("state").String())
+	})
+
+	t.Run("report the missing default models", func(t *testing.T) {
+		emptyDir := paths.New(t.TempDir())
+		require.NoError(t, emptyDir.Join("models-list.yaml").WriteFile([]byte("models: []\n")))
+		emptyModelsIndex, err := modelsindex.GenerateModelsIndexFromFile(emptyDir)
+		require.NoError(t, err)
+
+		res, err := ImportApp(t.Context(), bytes.NewReader(export(t, false)), ImportAppRequest{Name: f.Ptr("no-models")}, idProvider, bricksIndex, emptyModelsIndex, cfg)
+		require.NoError(t, err)
+		require.Equal(t, []string{"yolox-object-detection", "missing-model"}, res.MissingModels)
+	})
+
+	t.Run("reject too large archives", func(t *testing.T) {
+		defer func(size int64) { maxAppArchiveExtractedSize = size }(maxAppArchiveExtractedSize)
+		maxAppArchiveExtractedSize = 10
+
+		_, err := ImportApp(t.Context(), bytes.NewReader(export(t, true)), ImportAppRequest{Name: f.Ptr("too-large")}, idProvider, bricksIndex, modelsIndex, cfg)
+		require.ErrorIs(t, err, ErrAppArchiveTooLarge)
+		require.NoDirExists(t, cfg.AppsDir().Join("too-large").String())
+	})
+
+	t.Run("reject too large exports", func(t *testing.T) {
+		defer func(size int64) { maxAppArchiveSize = size }(maxAppArchiveSize)
+		maxAppArchiveSize = int64(len(export(t, false))) + 1024
+
+		// Random content is not compressed.
+		content := make([]byte, 4096)
+		_, _ = rand.Read(content)
+		large := exported.FullPath.Join("data", "large.bin")
+		require.NoError(t, large.WriteFile(content))
+		defer func() { _ = large.Remove() }()
+
+		var buf bytes.Buffer
+		require.NoError(t, ExportApp(t.Context(), &buf, exported, ExportAppRequest{}, bricksIndex, cfg))
+		buf.Reset()
+		err := ExportApp(t.Context(), &buf, exported, ExportAppRequest{IncludeData: true}, bricksIndex, cfg)
+		require.ErrorIs(t, err, ErrAppArchiveTooLarge)
+	})
+
+	t.Run("import an existing app", func(t *testing.T) {
+		_, err := ImportApp(t.Context(), bytes.NewReader(export(t, false)), ImportAppRequest{}, idProvider, bricksIndex, modelsIndex, cfg)
+		require.ErrorIs(t, err, ErrAppAlreadyExists)
+	})
+
+	t.Run("reject path traversal", func(t *testing.T) {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+		manifest := []byte("format_version: 1\nname: evil\n")
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "manifest.yaml", Mode: 0644, Size: int64(len(manifest))}))
+		_, err := tw.Write(manifest)
+		require.NoError(t, err)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "app/../../evil.txt", Mode: 0644}))
+		require.NoError(t, tw.Close())
+		require.NoError(t, gw.Close())
+
+		_, err = ImportApp(t.Context(), &buf, ImportAppRequest{}, idProvider, bricksIndex, modelsIndex, cfg)
+		require.ErrorIs(t, err, ErrInvalidAppArchive)
+		require.NoDirExists(t, cfg.AppsDir().Join("evil").String())
+	})
+}
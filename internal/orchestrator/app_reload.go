@@ -0,0 +1,187 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/docker/cli/cli/command"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+var ErrAppNotRunning = errors.New("app is not running")
+
+// ReloadAppRequest selects the parts of the app to reload.
+type ReloadAppRequest struct {
+	Python bool
+	Sketch bool
+}
+
+// ReloadApp applies the changes made to the code of a running app without restarting
+// the bricks: only the main service is restarted for the Python part, while the sketch
+// is compiled and uploaded again only if it changed since it has been loaded.
+func ReloadApp(
+	ctx context.Context,
+	docker command.Cli,
+	a app.ArduinoApp,
+	cfg config.Configuration,
+	req ReloadAppRequest,
+) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		status, err := getAppStatus(ctx, docker, a, cfg)
+		if err != nil || (status.Status != StatusRunning && status.Status != StatusStarting) {
+			yield(StreamMessage{error: fmt.Errorf("%w: %s", ErrAppNotRunning, a.Name)})
+			return
+		}
+		if !yield(StreamMessage{data: fmt.Sprintf("Reloading app %q", a.Name)}) {
+			return
+		}
+
+		callbackWriter := NewCallbackWriter(func(line string) {
+			if !yield(StreamMessage{data: line}) {
+				cancel()
+				return
+			}
+		})
+		if req.Sketch && a.MainSketchPath != nil {
+			if !yield(StreamMessage{progress: &Progress{Name: "sketch reloading", Progress: 0.0}}) {
+				return
+			}
+			if isSketchUnchanged(cfg, &a) {
+				if !yield(StreamMessage{data: "sketch unchanged, skipping upload"}) {
+					return
+				}
+			} else {
+				opts := sketchBuildOptions{
+					onDiagnostic: func(d SketchDiagnostic) {
+						if ctx.Err() != nil {
+							return
+						}
+						if !yield(StreamMessage{diagnostic: &d}) {
+							cancel()
+						}
+					},
+				}
+				if err := compileUploadSketch(ctx, &a, cfg, callbackWriter, opts); err != nil {
+					yield(StreamMessage{error: err})
+					return
+				}
+				if err := saveSketchState(cfg, &a); err != nil {
+					slog.Warn("unable to save sketch state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+				}
+			}
+		}
+
+		if req.Python && a.MainPythonFile != nil {
+			if !yield(StreamMessage{progress: &Progress{Name: "python reloading", Progress: 50.0}}) {
+				return
+			}
+			commands := []string{"docker", "compose", "-f", a.AppComposeFilePath().String()}
+			if ok, _ := a.AppComposeOverrideFilePath().ExistCheck(); ok {
+				commands = append(commands, "-f", a.AppComposeOverrideFilePath().String())
+			}
+			// Only the main service is restarted, the bricks are left untouched.
+			commands = append(commands, "restart", "--no-deps", fmt.Sprintf("--timeout=%d", DefaultDockerStopTimeoutSeconds), "main")
+			process, err := paths.NewProcess(nil, commands...)
+			if err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+			process.RedirectStderrTo(callbackWriter)
+			process.RedirectStdoutTo(callbackWriter)
+			if err := process.RunWithinContext(ctx); err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+		}
+		_ = yield(StreamMessage{progress: &Progress{Name: "", Progress: 100.0}})
+	}
+}
+
+// isSketchUnchanged reports whether the micro is running the current sketch of the app.
+func isSketchUnchanged(cfg config.Configuration, a *app.ArduinoApp) bool {
+	loaded, err := readSketchState(cfg)
+	if err != nil || loaded == nil || loaded.AppPath != a.FullPath.String() || !isSketchLoaded(loaded) {
+		return false
+	}
+	hash, err := hashDir(a.MainSketchPath)
+	return err == nil && hash == loaded.Hash
+}
+
+// WatchAppChanges polls the Python and the sketch folders of the app and reports
+// the parts that changed, once their content has been stable for an interval.
+// The watch is stopped when the context is done.
+func WatchAppChanges(ctx context.Context, a app.ArduinoApp, interval time.Duration) iter.Seq[ReloadAppRequest] {
+	return func(yield func(ReloadAppRequest) bool) {
+		hashOf := func(dir *paths.Path) string {
+			if dir == nil {
+				return ""
+			}
+			hash, err := hashDir(dir)
+			if err != nil {
+				slog.Debug("unable to hash folder", slog.String("path", dir.String()), slog.String("error", err.Error()))
+			}
+			return hash
+		}
+		var pythonDir *paths.Path
+		if a.MainPythonFile != nil {
+			pythonDir = a.MainPythonFile.Parent()
+		}
+
+		pythonHash, sketchHash := hashOf(pythonDir), hashOf(a.MainSketchPath)
+		var pending ReloadAppRequest
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			newPythonHash, newSketchHash := hashOf(pythonDir), hashOf(a.MainSketchPath)
+			changing := false
+			if newPythonHash != pythonHash {
+				pythonHash = newPythonHash
+				pending.Python = true
+				changing = true
+			}
+			if newSketchHash != sketchHash {
+				sketchHash = newSketchHash
+				pending.Sketch = true
+				changing = true
+			}
+			if changing || pending == (ReloadAppRequest{}) {
+				continue
+			}
+			if !yield(pending) {
+				return
+			}
+			pending = ReloadAppRequest{}
+		}
+	}
+}
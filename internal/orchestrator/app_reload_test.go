@@ -0,0 +1,65 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestWatchAppChanges(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	changes := make(chan ReloadAppRequest)
+	go func() {
+		defer close(changes)
+		for req := range WatchAppChanges(ctx, a, 20*time.Millisecond) {
+			changes <- req
+		}
+	}()
+
+	// Give the watcher the time to compute the initial hashes.
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, a.MainPythonFile.WriteFile([]byte("print('changed')\n")))
+	require.Equal(t, ReloadAppRequest{Python: true}, <-changes)
+
+	require.NoError(t, a.MainSketchPath.Join("extra.h").WriteFile([]byte("#define FOO 1\n")))
+	require.Equal(t, ReloadAppRequest{Sketch: true}, <-changes)
+
+	require.NoError(t, a.MainPythonFile.Parent().Join("__pycache__").MkdirAll())
+	require.NoError(t, a.MainPythonFile.Parent().Join("__pycache__", "main.cpython-313.pyc").WriteFile([]byte("bytecode")))
+	select {
+	case req := <-changes:
+		t.Fatalf("unexpected change detected: %+v", req)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	cancel()
+	_, ok := <-changes
+	require.False(t, ok)
+}
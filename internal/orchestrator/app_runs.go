@@ -0,0 +1,258 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+const (
+	// maxAppRuns is the number of runs kept in the history of each app.
+	maxAppRuns = 20
+	// maxAppRunOutputLines is the number of output lines kept for each run,
+	// the oldest lines are dropped first.
+	maxAppRunOutputLines = 500
+	// maxAppRunPhases is the number of phases kept for each run, the oldest
+	// phases are dropped first.
+	maxAppRunPhases = 100
+)
+
+type AppRunAction string
+
+const (
+	AppRunStart AppRunAction = "start"
+	AppRunStop  AppRunAction = "stop"
+)
+
+type AppRunStatus string
+
+const (
+	AppRunRunning     AppRunStatus = "running"
+	AppRunSucceeded   AppRunStatus = "succeeded"
+	AppRunFailed      AppRunStatus = "failed"
+	AppRunInterrupted AppRunStatus = "interrupted"
+)
+
+// AppRun is the record of a single start or stop of an app.
+type AppRun struct {
+	ID              int           `json:"id" required:"true"`
+	Action          AppRunAction  `json:"action" required:"true"`
+	Status          AppRunStatus  `json:"status" required:"true"`
+	StartedAt       time.Time     `json:"started_at" required:"true"`
+	FinishedAt      *time.Time    `json:"finished_at,omitempty"`
+	Phases          []AppRunPhase `json:"phases"`
+	Output          []string      `json:"output"`
+	OutputTruncated bool          `json:"output_truncated,omitempty"`
+	Error           string        `json:"error,omitempty"`
+}
+
+type AppRunPhase struct {
+	Name     string    `json:"name"`
+	Progress float32   `json:"progress"`
+	At       time.Time `json:"at"`
+}
+
+type AppRunsResult struct {
+	Runs []AppRun `json:"runs"`
+}
+
+// appRunRecord is a run as stored in the history of the app.
+type appRunRecord struct {
+	AppRun
+	// PID is the process recording the run.
+	PID int `json:"pid,omitempty"`
+}
+
+var appRunsMux sync.Mutex
+
+// liveAppRuns contains the runs recorded by this process that are still going on.
+var liveAppRuns = map[string]bool{}
+
+func appRunKey(a *app.ArduinoApp, id int) string {
+	return fmt.Sprintf("%s#%d", a.FullPath, id)
+}
+
+// GetAppRuns returns the history of the runs of the app, the most recent first.
+func GetAppRuns(a app.ArduinoApp) (AppRunsResult, error) {
+	appRunsMux.Lock()
+	defer appRunsMux.Unlock()
+
+	records, err := readAppRuns(&a)
+	if err != nil {
+		return AppRunsResult{}, err
+	}
+	runs := make([]AppRun, len(records))
+	for i, record := range records {
+		runs[len(records)-1-i] = record.AppRun
+	}
+	return AppRunsResult{Runs: runs}, nil
+}
+
+// readAppRuns reads the history of the app. The runs left running by a process that crashed
+// or has been killed are marked as interrupted.
+func readAppRuns(a *app.ArduinoApp) ([]appRunRecord, error) {
+	data, err := a.RunsFilePath().ReadFile()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []appRunRecord{}, nil
+		}
+		return nil, err
+	}
+	var runs []appRunRecord
+	if err := json.Unmarshal(data, &runs); err != nil {
+		return nil, fmt.Errorf("invalid runs history: %w", err)
+	}
+	for i := range runs {
+		if runs[i].Status == AppRunRunning && !isAppRunLive(a, runs[i]) {
+			runs[i].Status = AppRunInterrupted
+		}
+	}
+	return runs, nil
+}
+
+// isAppRunLive reports whether the process recording the run is still recording it.
+func isAppRunLive(a *app.ArduinoApp, run appRunRecord) bool {
+	if run.PID == 0 {
+		return false
+	}
+	if run.PID == os.Getpid() {
+		return liveAppRuns[appRunKey(a, run.ID)]
+	}
+	p, err := os.FindProcess(run.PID)
+	if err != nil {
+		return false
+	}
+	return !errors.Is(p.Signal(syscall.Signal(0)), os.ErrProcessDone)
+}
+
+// saveAppRun inserts or updates the run in the history of the app, keeping
+// only the last maxAppRuns runs.
+func saveAppRun(a *app.ArduinoApp, run *AppRun) error {
+	appRunsMux.Lock()
+	defer appRunsMux.Unlock()
+
+	runs, err := readAppRuns(a)
+	if err != nil {
+		slog.Warn("discarding runs history", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+		runs = []appRunRecord{}
+	}
+	if run.ID == 0 {
+		run.ID = 1
+		if len(runs) > 0 {
+			run.ID = runs[len(runs)-1].ID + 1
+		}
+	}
+	if run.Status == AppRunRunning {
+		liveAppRuns[appRunKey(a, run.ID)] = true
+	} else {
+		delete(liveAppRuns, appRunKey(a, run.ID))
+	}
+	record := appRunRecord{AppRun: *run, PID: os.Getpid()}
+	if idx := slices.IndexFunc(runs, func(r appRunRecord) bool { return r.ID == run.ID }); idx != -1 {
+		runs[idx] = record
+	} else {
+		runs = append(runs, record)
+	}
+	if len(runs) > maxAppRuns {
+		runs = runs[len(runs)-maxAppRuns:]
+	}
+
+	data, err := json.Marshal(runs)
+	if err != nil {
+		return err
+	}
+	if err := a.ProvisioningStateDir().MkdirAll(); err != nil {
+		return err
+	}
+	return fatomic.WriteFile(a.RunsFilePath().String(), data, os.FileMode(0644))
+}
+
+// recordAppRun wraps a start or stop stream, recording in the history of the app
+// its progress phases, its output and the final error. The run is saved when it
+// begins and when it ends, so that it survives the disconnection of the client.
+func recordAppRun(a app.ArduinoApp, action AppRunAction, stream iter.Seq[StreamMessage]) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		run := &AppRun{
+			Action:    action,
+			Status:    AppRunRunning,
+			StartedAt: time.Now(),
+			Phases:    []AppRunPhase{},
+			Output:    []string{},
+		}
+		save := func() {
+			if err := saveAppRun(&a, run); err != nil {
+				slog.Warn("unable to save app run", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+			}
+		}
+		save()
+
+		finish := func(status AppRunStatus) {
+			if run.FinishedAt != nil {
+				return
+			}
+			now := time.Now()
+			run.FinishedAt = &now
+			run.Status = status
+			save()
+		}
+		defer finish(AppRunInterrupted)
+
+		for msg := range stream {
+			switch {
+			case msg.IsError():
+				// The error ends the stream, the run is saved before handing it
+				// over since the consumer may exit right away.
+				run.Error = msg.GetError().Error()
+				finish(AppRunFailed)
+			case msg.IsProgress():
+				if p := msg.GetProgress(); p.Name != "" {
+					phase := AppRunPhase{Name: p.Name, Progress: p.Progress, At: time.Now()}
+					// The progress of the same phase is reported many times, keep only the last one.
+					if n := len(run.Phases); n > 0 && run.Phases[n-1].Name == p.Name {
+						run.Phases[n-1] = phase
+					} else {
+						run.Phases = append(run.Phases, phase)
+					}
+					if len(run.Phases) > maxAppRunPhases {
+						run.Phases = slices.Delete(run.Phases, 0, len(run.Phases)-maxAppRunPhases)
+					}
+				}
+			case msg.IsData():
+				run.Output = append(run.Output, msg.GetData())
+				if len(run.Output) > maxAppRunOutputLines {
+					run.Output = slices.Delete(run.Output, 0, len(run.Output)-maxAppRunOutputLines)
+					run.OutputTruncated = true
+				}
+			}
+			if !yield(msg) {
+				return
+			}
+		}
+		finish(AppRunSucceeded)
+	}
+}
@@ -0,0 +1,160 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestRecordAppRun(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	streamOf := func(msgs ...StreamMessage) iter.Seq[StreamMessage] {
+		return func(yield func(StreamMessage) bool) {
+			for _, msg := range msgs {
+				if !yield(msg) {
+					return
+				}
+			}
+		}
+	}
+	consume := func(stream iter.Seq[StreamMessage]) {
+		for msg := range stream {
+			if msg.IsError() {
+				return
+			}
+		}
+	}
+
+	consume(recordAppRun(a, AppRunStart, streamOf(
+		StreamMessage{progress: &Progress{Name: "preparing", Progress: 0}},
+		StreamMessage{data: "compiling sketch"},
+		StreamMessage{error: errors.New("compilation failed")},
+	)))
+	consume(recordAppRun(a, AppRunStop, streamOf(
+		StreamMessage{data: "stopping"},
+		StreamMessage{progress: &Progress{Name: "", Progress: 100}},
+	)))
+
+	res, err := GetAppRuns(a)
+	require.NoError(t, err)
+	require.Len(t, res.Runs, 2)
+
+	stop, start := res.Runs[0], res.Runs[1]
+	require.Equal(t, 2, stop.ID)
+	require.Equal(t, AppRunStop, stop.Action)
+	require.Equal(t, AppRunSucceeded, stop.Status)
+	require.Empty(t, stop.Phases)
+	require.Equal(t, []string{"stopping"}, stop.Output)
+
+	require.Equal(t, 1, start.ID)
+	require.Equal(t, AppRunFailed, start.Status)
+	require.Equal(t, "compilation failed", start.Error)
+	require.Len(t, start.Phases, 1)
+	require.Equal(t, "preparing", start.Phases[0].Name)
+	require.Equal(t, []string{"compiling sketch"}, start.Output)
+	require.NotNil(t, start.FinishedAt)
+
+	t.Run("interrupted by the consumer", func(t *testing.T) {
+		for range recordAppRun(a, AppRunStart, streamOf(StreamMessage{data: "a"}, StreamMessage{data: "b"})) {
+			break
+		}
+		res, err := GetAppRuns(a)
+		require.NoError(t, err)
+		require.Equal(t, AppRunInterrupted, res.Runs[0].Status)
+		require.Equal(t, []string{"a"}, res.Runs[0].Output)
+	})
+
+	t.Run("left running by a crashed process", func(t *testing.T) {
+		a, err := app.Load(createApp(t, "app2", false, idProvider, cfg).ToPath().String())
+		require.NoError(t, err)
+
+		for range recordAppRun(a, AppRunStart, streamOf(StreamMessage{data: "a"})) {
+			res, err := GetAppRuns(a)
+			require.NoError(t, err)
+			require.Equal(t, AppRunRunning, res.Runs[0].Status, "the run is going on")
+		}
+
+		exited := exec.Command("true")
+		require.NoError(t, exited.Run())
+		appRunsMux.Lock()
+		runs, err := readAppRuns(&a)
+		require.NoError(t, err)
+		for _, pid := range []int{exited.Process.Pid, os.Getpid(), 0} {
+			runs = append(runs, appRunRecord{
+				AppRun: AppRun{ID: runs[len(runs)-1].ID + 1, Action: AppRunStart, Status: AppRunRunning},
+				PID:    pid,
+			})
+		}
+		data, err := json.Marshal(runs)
+		require.NoError(t, err)
+		require.NoError(t, a.RunsFilePath().WriteFile(data))
+		appRunsMux.Unlock()
+
+		res, err := GetAppRuns(a)
+		require.NoError(t, err)
+		for _, run := range res.Runs[:3] {
+			require.Equal(t, AppRunInterrupted, run.Status)
+		}
+	})
+
+	t.Run("history and output are bounded", func(t *testing.T) {
+		msgs := make([]StreamMessage, maxAppRunOutputLines+10)
+		for i := range msgs {
+			msgs[i] = StreamMessage{data: fmt.Sprintf("line %d", i)}
+		}
+		for range maxAppRuns {
+			consume(recordAppRun(a, AppRunStart, streamOf(msgs...)))
+		}
+		res, err := GetAppRuns(a)
+		require.NoError(t, err)
+		require.Len(t, res.Runs, maxAppRuns)
+		require.Equal(t, 3+maxAppRuns, res.Runs[0].ID)
+		require.Len(t, res.Runs[0].Output, maxAppRunOutputLines)
+		require.True(t, res.Runs[0].OutputTruncated)
+		require.Equal(t, "line 10", res.Runs[0].Output[0])
+	})
+
+	t.Run("phases are bounded", func(t *testing.T) {
+		var msgs []StreamMessage
+		for i := range maxAppRunPhases + 10 {
+			name := fmt.Sprintf("phase %d", i)
+			for progress := range 50 {
+				msgs = append(msgs, StreamMessage{progress: &Progress{Name: name, Progress: float32(progress * 2)}})
+			}
+		}
+		consume(recordAppRun(a, AppRunStart, streamOf(msgs...)))
+		res, err := GetAppRuns(a)
+		require.NoError(t, err)
+		phases := res.Runs[0].Phases
+		require.Len(t, phases, maxAppRunPhases)
+		require.Equal(t, "phase 10", phases[0].Name)
+		require.Equal(t, float32(98), phases[0].Progress)
+	})
+}
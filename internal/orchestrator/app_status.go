@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"iter"
 	"log/slog"
+	"time"
 
+	"github.com/arduino/go-paths-helper"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/filters"
@@ -29,6 +31,9 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
+// sketchStatusPollInterval is the interval used to check the status of the sketch-only apps.
+const sketchStatusPollInterval = 2 * time.Second
+
 func AppStatusEvents(ctx context.Context, cfg config.Configuration, docker command.Cli, idProvider *app.IDProvider) iter.Seq2[AppInfo, error] {
 	chanMsg, chanError := docker.Client().Events(ctx, events.ListOptions{
 		Filters: filters.NewArgs(
@@ -45,6 +50,11 @@ func AppStatusEvents(ctx context.Context, cfg config.Configuration, docker comma
 	})
 
 	return func(yield func(AppInfo, error) bool) {
+		// The sketch-only apps have no containers, their status is polled from the micro.
+		sketchTicker := time.NewTicker(sketchStatusPollInterval)
+		defer sketchTicker.Stop()
+		sketchApp := getSketchAppPath(cfg)
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -72,6 +82,29 @@ func AppStatusEvents(ctx context.Context, cfg config.Configuration, docker comma
 				if !yield(appStatus, nil) {
 					return
 				}
+			case <-sketchTicker.C:
+				current := getSketchAppPath(cfg)
+				if current == sketchApp {
+					continue
+				}
+				var changed []AppStatusInfo
+				if sketchApp != "" {
+					changed = append(changed, AppStatusInfo{AppPath: paths.New(sketchApp), Status: StatusStopped})
+				}
+				if current != "" {
+					changed = append(changed, AppStatusInfo{AppPath: paths.New(current), Status: StatusRunning})
+				}
+				sketchApp = current
+				for _, status := range changed {
+					appInfo, err := getAppInfoFromStatus(cfg, idProvider, status)
+					if err != nil {
+						slog.Warn("unable to get sketch app status", slog.String("path", status.AppPath.String()), slog.String("error", err.Error()))
+						continue
+					}
+					if !yield(appInfo, nil) {
+						return
+					}
+				}
 			}
 
 		}
@@ -91,36 +124,52 @@ func parseDockerStatusEvent(ctx context.Context, cfg config.Configuration, docke
 			return AppInfo{}, fmt.Errorf("app containers not found for: %s", pathLabel)
 		}
 
-		defaultApp, err := GetDefaultApp(cfg)
-		if err != nil {
-			slog.Warn("unable to get default app", slog.String("error", err.Error()))
-		}
-
-		// FIXME: create an helper function to transform an app.ArduinoApp into an ortchestrator.AppInfo
-		app, err := app.Load(appStatus.AppPath.String())
-		if err != nil {
-			slog.Warn("error loading app", "appPath", appStatus.AppPath.String(), "error", err)
-			return AppInfo{}, err
-		}
+		return getAppInfoFromStatus(cfg, idProvider, *appStatus)
+	}
+	return AppInfo{}, fmt.Errorf("unable to find app path label in event")
 
-		id, err := idProvider.IDFromPath(appStatus.AppPath)
-		if err != nil {
-			return AppInfo{}, err
-		}
+}
 
-		isDefault := defaultApp != nil && defaultApp.FullPath.EqualsTo(app.FullPath)
+func getAppInfoFromStatus(cfg config.Configuration, idProvider *app.IDProvider, status AppStatusInfo) (AppInfo, error) {
+	defaultApp, err := GetDefaultApp(cfg)
+	if err != nil {
+		slog.Warn("unable to get default app", slog.String("error", err.Error()))
+	}
 
-		return AppInfo{
-			ID:          id,
-			Name:        app.Descriptor.Name,
-			Description: app.Descriptor.Description,
-			Icon:        app.Descriptor.Icon,
-			Status:      appStatus.Status,
-			Example:     id.IsExample(),
-			Default:     isDefault,
-		}, nil
+	// FIXME: create an helper function to transform an app.ArduinoApp into an ortchestrator.AppInfo
+	app, err := app.Load(status.AppPath.String())
+	if err != nil {
+		slog.Warn("error loading app", "appPath", status.AppPath.String(), "error", err)
+		return AppInfo{}, err
+	}
 
+	id, err := idProvider.IDFromPath(status.AppPath)
+	if err != nil {
+		return AppInfo{}, err
 	}
-	return AppInfo{}, fmt.Errorf("unable to find app path label in event")
 
+	isDefault := defaultApp != nil && defaultApp.FullPath.EqualsTo(app.FullPath)
+
+	return AppInfo{
+		ID:          id,
+		Name:        app.Descriptor.Name,
+		Description: app.Descriptor.Description,
+		Icon:        app.Descriptor.Icon,
+		Status:      status.Status,
+		Example:     id.IsExample(),
+		Default:     isDefault,
+	}, nil
+}
+
+// getSketchAppPath returns the path of the sketch-only app running on the micro, if any.
+func getSketchAppPath(cfg config.Configuration) string {
+	status, err := getSketchAppStatus(cfg)
+	if err != nil {
+		slog.Warn("unable to get sketch status", slog.String("error", err.Error()))
+		return ""
+	}
+	if status == nil {
+		return ""
+	}
+	return status.AppPath.String()
 }
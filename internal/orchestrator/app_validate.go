@@ -0,0 +1,453 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+type DiagnosticSeverity string
+
+const (
+	DiagnosticError   DiagnosticSeverity = "error"
+	DiagnosticWarning DiagnosticSeverity = "warning"
+)
+
+// AppDiagnostic is a problem found in the app descriptor. Line and Column
+// are 1-based and refer to the app.yaml file, they are zero when the
+// problem is not related to a specific position.
+type AppDiagnostic struct {
+	Severity DiagnosticSeverity `json:"severity" required:"true"`
+	Message  string             `json:"message" required:"true"`
+	Field    string             `json:"field,omitempty"`
+	Line     int                `json:"line,omitempty"`
+	Column   int                `json:"column,omitempty"`
+}
+
+type AppValidationResult struct {
+	File        string          `json:"file" required:"true"`
+	Valid       bool            `json:"valid" required:"true"`
+	Diagnostics []AppDiagnostic `json:"diagnostics" required:"true"`
+}
+
+var knownDeviceClasses = []string{CameraDevice, MicrophoneDevice, SpeakerDevice}
+
+var knownDescriptorKeys = []string{"name", "description", "ports", "bricks", "icon", "required_devices", "restart_policy", "sketch"}
+
+var knownBrickKeys = []string{"model", "variables"}
+
+var knownRestartPolicyKeys = []string{"mode", "max_retries", "backoff"}
+
+var knownSketchKeys = []string{"deploy"}
+
+type descriptorValidator struct {
+	bricksIndex *bricksindex.BricksIndex
+	modelsIndex *modelsindex.ModelsIndex
+	diagnostics []AppDiagnostic
+}
+
+// ValidateApp checks the descriptor of the app in appPath and reports every
+// problem found, cross-checking bricks, models and devices with the indexes.
+// The app does not need to be loadable, so that broken apps can be inspected.
+func ValidateApp(
+	appPath *paths.Path,
+	bricksIndex *bricksindex.BricksIndex,
+	modelsIndex *modelsindex.ModelsIndex,
+) (AppValidationResult, error) {
+	descriptorFile := (&app.ArduinoApp{FullPath: appPath}).GetDescriptorPath()
+	result := AppValidationResult{File: descriptorFile.String(), Diagnostics: []AppDiagnostic{}}
+
+	content, err := descriptorFile.ReadFile()
+	if err != nil {
+		return result, fmt.Errorf("cannot read app descriptor: %w", err)
+	}
+
+	v := &descriptorValidator{bricksIndex: bricksIndex, modelsIndex: modelsIndex}
+	v.validate(content)
+
+	result.Diagnostics = append(result.Diagnostics, v.diagnostics...)
+	result.Valid = !slices.ContainsFunc(result.Diagnostics, func(d AppDiagnostic) bool {
+		return d.Severity == DiagnosticError
+	})
+	return result, nil
+}
+
+func (v *descriptorValidator) report(severity DiagnosticSeverity, node ast.Node, field string, format string, args ...any) {
+	d := AppDiagnostic{
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+		Field:    field,
+	}
+	if node != nil {
+		if tk := node.GetToken(); tk != nil && tk.Position != nil {
+			d.Line = tk.Position.Line
+			d.Column = tk.Position.Column
+		}
+	}
+	v.diagnostics = append(v.diagnostics, d)
+}
+
+func (v *descriptorValidator) validate(content []byte) {
+	file, err := parser.ParseBytes(content, 0)
+	if err != nil {
+		d := AppDiagnostic{Severity: DiagnosticError, Message: err.Error()}
+		var syntaxErr *yaml.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			d.Message = syntaxErr.GetMessage()
+			if tk := syntaxErr.GetToken(); tk != nil && tk.Position != nil {
+				d.Line = tk.Position.Line
+				d.Column = tk.Position.Column
+			}
+		}
+		v.diagnostics = append(v.diagnostics, d)
+		return
+	}
+	if len(file.Docs) == 0 || file.Docs[0].Body == nil {
+		v.report(DiagnosticError, nil, "", "app descriptor is empty")
+		return
+	}
+
+	root := unwrapNode(file.Docs[0].Body)
+	values, ok := mappingValues(root)
+	if !ok {
+		v.report(DiagnosticError, root, "", "app descriptor must be a mapping")
+		return
+	}
+
+	var nameFound bool
+	for _, kv := range values {
+		key := kv.Key.GetToken().Value
+		value := unwrapNode(kv.Value)
+		switch key {
+		case "name":
+			nameFound = true
+			if name, ok := scalarString(value); !ok || name == "" {
+				v.report(DiagnosticError, kv.Key, key, "application name is empty")
+			}
+		case "description":
+			if _, ok := scalarString(value); !ok && value.Type() != ast.NullType {
+				v.report(DiagnosticError, value, key, "description must be a string")
+			}
+		case "icon":
+			if icon, ok := scalarString(value); ok && icon != "" {
+				if err := (&app.AppDescriptor{Icon: icon}).IsValid(); err != nil {
+					v.report(DiagnosticError, value, key, "%s", err)
+				}
+			}
+		case "ports":
+			v.validatePorts(value)
+		case "bricks":
+			v.validateBricks(value)
+		case "required_devices":
+			v.validateDevices(value)
+		case "restart_policy":
+			v.validateRestartPolicy(value)
+		case "sketch":
+			v.validateSketchSettings(value)
+		default:
+			v.report(DiagnosticWarning, kv.Key, key, "unknown key %q, allowed keys are: %v", key, knownDescriptorKeys)
+		}
+	}
+	if !nameFound {
+		v.report(DiagnosticError, root, "name", "application name is missing")
+	}
+}
+
+func (v *descriptorValidator) validatePorts(node ast.Node) {
+	if node.Type() == ast.NullType {
+		return
+	}
+	seq, ok := node.(*ast.SequenceNode)
+	if !ok {
+		v.report(DiagnosticError, node, "ports", "ports must be a list of port numbers")
+		return
+	}
+	seen := map[int64]bool{}
+	for i, item := range seq.Values {
+		field := fmt.Sprintf("ports[%d]", i)
+		item = unwrapNode(item)
+		intNode, ok := item.(*ast.IntegerNode)
+		if !ok {
+			v.report(DiagnosticError, item, field, "port %q is not a number", item.String())
+			continue
+		}
+		port, err := strconv.ParseInt(intNode.GetToken().Value, 0, 64)
+		if err != nil || port < 1 || port > 65535 {
+			v.report(DiagnosticError, item, field, "port %s is out of range (1-65535)", intNode.GetToken().Value)
+			continue
+		}
+		if seen[port] {
+			v.report(DiagnosticWarning, item, field, "port %d is listed more than once", port)
+		}
+		seen[port] = true
+	}
+}
+
+func (v *descriptorValidator) validateBricks(node ast.Node) {
+	if node.Type() == ast.NullType {
+		return
+	}
+	seq, ok := node.(*ast.SequenceNode)
+	if !ok {
+		v.report(DiagnosticError, node, "bricks", "bricks must be a list")
+		return
+	}
+	seen := map[string]bool{}
+	for i, item := range seq.Values {
+		field := fmt.Sprintf("bricks[%d]", i)
+		item = unwrapNode(item)
+
+		var (
+			idNode  ast.Node
+			details ast.Node
+		)
+		if _, ok := scalarString(item); ok {
+			idNode = item
+		} else if values, ok := mappingValues(item); ok && len(values) == 1 {
+			idNode = values[0].Key
+			details = unwrapNode(values[0].Value)
+		} else {
+			v.report(DiagnosticError, item, field, "brick must be a brick id or a single-key mapping")
+			continue
+		}
+
+		brickID := idNode.GetToken().Value
+		if seen[brickID] {
+			v.report(DiagnosticError, idNode, field, "brick %q is listed more than once", brickID)
+		}
+		seen[brickID] = true
+
+		brick, found := v.bricksIndex.FindBrickByID(brickID)
+		if !found {
+			v.report(DiagnosticError, idNode, field, "brick %q not found", brickID)
+		}
+
+		if details == nil || details.Type() == ast.NullType {
+			continue
+		}
+		values, ok := mappingValues(details)
+		if !ok {
+			v.report(DiagnosticError, details, field, "brick %q details must be a mapping", brickID)
+			continue
+		}
+		for _, kv := range values {
+			key := kv.Key.GetToken().Value
+			value := unwrapNode(kv.Value)
+			switch key {
+			case "model":
+				v.validateBrickModel(brickID, brick, value, field+".model")
+			case "variables":
+				v.validateBrickVariables(brickID, brick, value, field+".variables")
+			default:
+				v.report(DiagnosticWarning, kv.Key, field+"."+key, "unknown key %q, allowed keys are: %v", key, knownBrickKeys)
+			}
+		}
+	}
+}
+
+func (v *descriptorValidator) validateBrickModel(brickID string, brick *bricksindex.Brick, node ast.Node, field string) {
+	modelID, ok := scalarString(node)
+	if !ok {
+		v.report(DiagnosticError, node, field, "model must be a string")
+		return
+	}
+	if modelID == "" {
+		return
+	}
+	model, found := v.modelsIndex.GetModelByID(modelID)
+	if !found {
+		v.report(DiagnosticError, node, field, "model %q not found", modelID)
+		return
+	}
+	if brick != nil && !slices.Contains(model.Bricks, brickID) {
+		v.report(DiagnosticError, node, field, "model %q is not compatible with brick %q", modelID, brickID)
+		return
+	}
+	if brick != nil {
+		if err := modelsindex.CheckBrickCompatibility(*model, brick); err != nil {
+			v.report(DiagnosticError, node, field, "%s", err)
+		}
+	}
+}
+
+func (v *descriptorValidator) validateBrickVariables(brickID string, brick *bricksindex.Brick, node ast.Node, field string) {
+	if node.Type() == ast.NullType {
+		return
+	}
+	values, ok := mappingValues(node)
+	if !ok {
+		v.report(DiagnosticError, node, field, "variables must be a mapping")
+		return
+	}
+	for _, kv := range values {
+		name := kv.Key.GetToken().Value
+		value, ok := scalarString(unwrapNode(kv.Value))
+		if !ok {
+			v.report(DiagnosticError, kv.Value, field+"."+name, "variable %q must be a scalar value", name)
+		}
+		if brick == nil {
+			continue
+		}
+		variable, found := brick.GetVariable(name)
+		if !found {
+			v.report(DiagnosticWarning, kv.Key, field+"."+name, "variable %q is not defined by brick %q", name, brickID)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err := variable.Validate(value); err != nil {
+			v.report(DiagnosticError, kv.Value, field+"."+name, "variable %q %s", name, err)
+		}
+	}
+}
+
+func (v *descriptorValidator) validateDevices(node ast.Node) {
+	if node.Type() == ast.NullType {
+		return
+	}
+	seq, ok := node.(*ast.SequenceNode)
+	if !ok {
+		v.report(DiagnosticError, node, "required_devices", "required_devices must be a list")
+		return
+	}
+	for i, item := range seq.Values {
+		field := fmt.Sprintf("required_devices[%d]", i)
+		item = unwrapNode(item)
+		class, ok := scalarString(item)
+		if !ok || !slices.Contains(knownDeviceClasses, class) {
+			v.report(DiagnosticError, item, field, "unknown device class %q, allowed classes are: %v", item.String(), knownDeviceClasses)
+		}
+	}
+}
+
+func (v *descriptorValidator) validateRestartPolicy(node ast.Node) {
+	if node.Type() == ast.NullType {
+		return
+	}
+	values, ok := mappingValues(node)
+	if !ok {
+		v.report(DiagnosticError, node, "restart_policy", "restart_policy must be a mapping")
+		return
+	}
+	var modeFound bool
+	for _, kv := range values {
+		key := kv.Key.GetToken().Value
+		field := "restart_policy." + key
+		value := unwrapNode(kv.Value)
+		str, _ := scalarString(value)
+		// Each field is checked alone, so that errors are reported on the offending node.
+		policy := app.RestartPolicy{Mode: app.RestartNever}
+		switch key {
+		case "mode":
+			modeFound = true
+			policy.Mode = app.RestartPolicyMode(str)
+		case "max_retries":
+			retries, err := strconv.Atoi(str)
+			if err != nil {
+				v.report(DiagnosticError, value, field, "max_retries %q is not a number", value.String())
+				continue
+			}
+			policy.MaxRetries = retries
+		case "backoff":
+			policy.Backoff = str
+		default:
+			v.report(DiagnosticWarning, kv.Key, field, "unknown key %q, allowed keys are: %v", key, knownRestartPolicyKeys)
+			continue
+		}
+		if err := policy.IsValid(); err != nil {
+			v.report(DiagnosticError, value, field, "%s", err)
+		}
+	}
+	if !modeFound {
+		v.report(DiagnosticError, node, "restart_policy.mode", "restart policy mode is missing")
+	}
+}
+
+func (v *descriptorValidator) validateSketchSettings(node ast.Node) {
+	if node.Type() == ast.NullType {
+		return
+	}
+	values, ok := mappingValues(node)
+	if !ok {
+		v.report(DiagnosticError, node, "sketch", "sketch must be a mapping")
+		return
+	}
+	for _, kv := range values {
+		key := kv.Key.GetToken().Value
+		field := "sketch." + key
+		value := unwrapNode(kv.Value)
+		switch key {
+		case "deploy":
+			str, _ := scalarString(value)
+			settings := app.SketchSettings{Deploy: app.SketchDeployMode(str)}
+			if err := settings.IsValid(); err != nil {
+				v.report(DiagnosticError, value, field, "%s", err)
+			}
+		default:
+			v.report(DiagnosticWarning, kv.Key, field, "unknown key %q, allowed keys are: %v", key, knownSketchKeys)
+		}
+	}
+}
+
+// unwrapNode skips tags and anchors returning the underlying value node.
+func unwrapNode(node ast.Node) ast.Node {
+	for {
+		switch n := node.(type) {
+		case *ast.TagNode:
+			node = n.Value
+		case *ast.AnchorNode:
+			node = n.Value
+		default:
+			return node
+		}
+	}
+}
+
+func mappingValues(node ast.Node) ([]*ast.MappingValueNode, bool) {
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		return n.Values, true
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}, true
+	}
+	return nil, false
+}
+
+// scalarString returns the value of a scalar node as a string.
+func scalarString(node ast.Node) (string, bool) {
+	switch n := node.(type) {
+	case *ast.StringNode:
+		return n.Value, true
+	case *ast.LiteralNode:
+		return n.Value.Value, true
+	case *ast.IntegerNode, *ast.FloatNode, *ast.BoolNode:
+		return n.GetToken().Value, true
+	}
+	return "", false
+}
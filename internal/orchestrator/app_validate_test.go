@@ -0,0 +1,145 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func TestValidateApp(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+
+	require.NoError(t, cfg.AssetsDir().Join("bricks-list.yaml").WriteFile([]byte(`
+bricks:
+- id: arduino:object_detection
+  name: Object Detection
+  variables:
+  - name: EI_OBJ_DETECTION_MODEL
+    default_value: /models/yolo.eim
+- id: arduino:web_ui
+  name: Web UI
+`)))
+	require.NoError(t, cfg.AssetsDir().Join("models-list.yaml").WriteFile([]byte(`
+models:
+- yolox-object-detection:
+    runner: brick
+    name: "YoloX"
+    bricks:
+    - arduino:object_detection
+- face-detection:
+    runner: brick
+    name: "Face"
+    bricks:
+    - arduino:video_face_detection
+`)))
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+
+	validate := func(t *testing.T, content string) AppValidationResult {
+		appDir := paths.New(t.TempDir())
+		require.NoError(t, appDir.Join("app.yaml").WriteFile([]byte(content)))
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("valid app", func(t *testing.T) {
+		res := validate(t, `name: My App
+icon: 😃
+ports: [8080]
+bricks:
+- arduino:web_ui
+- arduino:object_detection:
+    model: yolox-object-detection
+    variables:
+      EI_OBJ_DETECTION_MODEL: /models/custom.eim
+required_devices:
+- camera
+restart_policy:
+  mode: on-failure
+  max_retries: 3
+  backoff: 10s
+sketch:
+  deploy: flash
+`)
+		require.True(t, res.Valid)
+		require.Empty(t, res.Diagnostics)
+	})
+
+	t.Run("invalid app", func(t *testing.T) {
+		res := validate(t, `name: My App
+color: red
+ports:
+- 8080
+- 70000
+- http
+bricks:
+- arduino:unknown
+- arduino:object_detection:
+    model: face-detection
+    variables:
+      NOT_A_VARIABLE: x
+- arduino:web_ui:
+    model: missing-model
+required_devices:
+- printer
+restart_policy:
+  mode: sometimes
+  backoff: soon
+sketch:
+  deploy: eeprom
+`)
+		require.False(t, res.Valid)
+		require.Equal(t, []AppDiagnostic{
+			{Severity: DiagnosticWarning, Field: "color", Line: 2, Column: 1, Message: `unknown key "color", allowed keys are: [name description ports bricks icon required_devices restart_policy sketch]`},
+			{Severity: DiagnosticError, Field: "ports[1]", Line: 5, Column: 3, Message: "port 70000 is out of range (1-65535)"},
+			{Severity: DiagnosticError, Field: "ports[2]", Line: 6, Column: 3, Message: `port "http" is not a number`},
+			{Severity: DiagnosticError, Field: "bricks[0]", Line: 8, Column: 3, Message: `brick "arduino:unknown" not found`},
+			{Severity: DiagnosticError, Field: "bricks[1].model", Line: 10, Column: 12, Message: `model "face-detection" is not compatible with brick "arduino:object_detection"`},
+			{Severity: DiagnosticWarning, Field: "bricks[1].variables.NOT_A_VARIABLE", Line: 12, Column: 7, Message: `variable "NOT_A_VARIABLE" is not defined by brick "arduino:object_detection"`},
+			{Severity: DiagnosticError, Field: "bricks[2].model", Line: 14, Column: 12, Message: `model "missing-model" not found`},
+			{Severity: DiagnosticError, Field: "required_devices[0]", Line: 16, Column: 3, Message: `unknown device class "printer", allowed classes are: [camera microphone speaker]`},
+			{Severity: DiagnosticError, Field: "restart_policy.mode", Line: 18, Column: 9, Message: `invalid restart policy mode "sometimes", allowed modes are: [never on-failure always]`},
+			{Severity: DiagnosticError, Field: "restart_policy.backoff", Line: 19, Column: 12, Message: `invalid restart policy backoff "soon", expected a positive duration (e.g. 5s, 1m)`},
+			{Severity: DiagnosticError, Field: "sketch.deploy", Line: 21, Column: 11, Message: `invalid sketch deploy mode "eeprom", allowed modes are: [ram flash]`},
+		}, res.Diagnostics)
+	})
+
+	t.Run("missing name and bad icon", func(t *testing.T) {
+		res := validate(t, "description: test\nicon: ab\n")
+		require.False(t, res.Valid)
+		require.Len(t, res.Diagnostics, 2)
+		require.Equal(t, "icon", res.Diagnostics[0].Field)
+		require.Equal(t, 2, res.Diagnostics[0].Line)
+		require.Equal(t, "application name is missing", res.Diagnostics[1].Message)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		res := validate(t, "name: My App\nbricks: [\n")
+		require.False(t, res.Valid)
+		require.Len(t, res.Diagnostics, 1)
+		require.Equal(t, DiagnosticError, res.Diagnostics[0].Severity)
+		require.NotZero(t, res.Diagnostics[0].Line)
+	})
+}
@@ -0,0 +1,210 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package assets keeps the indexes built from the provisioned assets, reloading them
+// when the assets change.
+package assets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+// Assets are the indexes built from a version of the assets. They are never modified
+// by a reload, that replaces them with new ones.
+type Assets struct {
+	Dir          *paths.Path
+	BricksIndex  *bricksindex.BricksIndex
+	ModelsIndex  *modelsindex.ModelsIndex
+	StaticStore  *store.StaticStore
+	BrickService *bricks.Service
+	LoadedAt     time.Time
+}
+
+// Registry holds the current Assets. The callers should get the assets once per operation,
+// so that an operation keeps using the same indexes while they are swapped.
+type Registry struct {
+	cfg     config.Configuration
+	current atomic.Pointer[Assets]
+
+	// mu serializes the reloads.
+	mu          sync.Mutex
+	fingerprint string
+	// trackLoaded records the loaded version in the data dir at each load.
+	trackLoaded bool
+}
+
+func NewRegistry(cfg config.Configuration) (*Registry, error) {
+	r := &Registry{cfg: cfg}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Get returns the current assets.
+func (r *Registry) Get() *Assets {
+	return r.current.Load()
+}
+
+// Reload loads the assets again and swaps them with the current ones. If the assets
+// cannot be loaded the current ones are kept.
+func (r *Registry) Reload() (*Assets, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reload()
+}
+
+func (r *Registry) reload() (*Assets, error) {
+	dir := r.cfg.AssetsDir().Join(r.cfg.UsedPythonImageTag)
+	fingerprint, err := r.computeFingerprint(dir)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := load(r.cfg, dir)
+	if err != nil {
+		return nil, err
+	}
+	r.current.Store(assets)
+	r.fingerprint = fingerprint
+	if r.trackLoaded {
+		r.writeLoadedVersion(assets)
+	}
+	slog.Info("assets loaded", slog.String("path", dir.String()), slog.Int("bricks", len(assets.BricksIndex.Bricks)), slog.Int("models", len(assets.ModelsIndex.GetModels())))
+	return assets, nil
+}
+
+func load(cfg config.Configuration, dir *paths.Path) (*Assets, error) {
+	staticStore := store.NewStaticStoreWithCustomBricks(dir.String(), cfg.CustomBricksDir())
+
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load the bricks index: %w", err)
+	}
+	if err := bricksIndex.AddCustomBricks(cfg.CustomBricksDir()); err != nil {
+		slog.Warn("unable to load custom bricks", slog.String("error", err.Error()))
+	}
+
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load the models index: %w", err)
+	}
+	if err := modelsIndex.AddCustomModels(cfg.CustomEIModelsDir()); err != nil {
+		slog.Warn("unable to load custom models", slog.String("error", err.Error()))
+	}
+
+	return &Assets{
+		Dir:          dir,
+		BricksIndex:  bricksIndex,
+		ModelsIndex:  modelsIndex,
+		StaticStore:  staticStore,
+		BrickService: bricks.NewService(modelsIndex, bricksIndex, staticStore),
+		LoadedAt:     time.Now(),
+	}, nil
+}
+
+// TrackLoadedVersion records the version of the loaded assets in the data dir, now and at
+// each load, so that the other processes sharing the data dir do not remove it. Only the
+// daemon tracks its assets, that it serves until the next start.
+func (r *Registry) TrackLoadedVersion() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.trackLoaded = true
+	if assets := r.current.Load(); assets != nil {
+		r.writeLoadedVersion(assets)
+	}
+}
+
+func (r *Registry) writeLoadedVersion(assets *Assets) {
+	file := r.cfg.LoadedAssetsFile()
+	if err := file.Parent().MkdirAll(); err != nil {
+		slog.Warn("unable to record the loaded assets", slog.String("error", err.Error()))
+		return
+	}
+	if err := file.WriteFile([]byte(assets.Dir.Base() + "\n")); err != nil {
+		slog.Warn("unable to record the loaded assets", slog.String("error", err.Error()))
+	}
+}
+
+// Watch polls the assets and reloads them when they change, until the context is done.
+func (r *Registry) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		r.mu.Lock()
+		dir := r.cfg.AssetsDir().Join(r.cfg.UsedPythonImageTag)
+		fingerprint, err := r.computeFingerprint(dir)
+		if err == nil && fingerprint != r.fingerprint {
+			slog.Info("assets changed, reloading", slog.String("path", dir.String()))
+			_, err = r.reload()
+		}
+		r.mu.Unlock()
+		if err != nil {
+			slog.Warn("unable to reload the assets", slog.String("error", err.Error()))
+		}
+	}
+}
+
+// computeFingerprint summarizes the files the indexes are built from. Only the metadata of
+// the files is used, to keep polling cheap.
+func (r *Registry) computeFingerprint(dir *paths.Path) (string, error) {
+	h := sha256.New()
+	for _, file := range []*paths.Path{dir.Join("bricks-list.yaml"), dir.Join("models-list.yaml")} {
+		info, err := file.Stat()
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00%d\x00", file, info.Size(), info.ModTime().UnixNano())
+	}
+	for _, custom := range []*paths.Path{r.cfg.CustomBricksDir(), r.cfg.CustomEIModelsDir()} {
+		if custom == nil || custom.NotExist() {
+			continue
+		}
+		files, err := custom.ReadDirRecursive()
+		if err != nil {
+			return "", err
+		}
+		files.Sort()
+		for _, file := range files {
+			info, err := file.Stat()
+			if err != nil {
+				// The file has been removed in the meantime.
+				continue
+			}
+			_, _ = fmt.Fprintf(h, "%s\x00%d\x00%d\x00", file, info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
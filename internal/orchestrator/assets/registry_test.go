@@ -0,0 +1,89 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package assets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func TestRegistry(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	t.Setenv("ARDUINO_APP_BRICKS__CUSTOM_MODEL_DIR", tmp.Join("models").String())
+	cfg, err := config.NewFromEnv()
+	require.NoError(t, err)
+
+	dir := cfg.AssetsDir().Join(cfg.UsedPythonImageTag)
+	require.NoError(t, dir.MkdirAll())
+	writeBricks := func(ids ...string) {
+		content := "bricks:\n"
+		for _, id := range ids {
+			content += "- id: " + id + "\n  name: " + id + "\n"
+		}
+		require.NoError(t, dir.Join("bricks-list.yaml").WriteFile([]byte(content)))
+	}
+	writeBricks("arduino:web_ui")
+	require.NoError(t, dir.Join("models-list.yaml").WriteFile([]byte("models: []\n")))
+
+	registry, err := NewRegistry(cfg)
+	require.NoError(t, err)
+	old := registry.Get()
+	require.Len(t, old.BricksIndex.Bricks, 1)
+
+	t.Run("reload swaps the assets", func(t *testing.T) {
+		writeBricks("arduino:web_ui", "arduino:dbstorage_tsstore")
+		res, err := registry.Reload()
+		require.NoError(t, err)
+		require.Same(t, res, registry.Get())
+		require.Len(t, registry.Get().BricksIndex.Bricks, 2)
+		// The assets in use are not modified.
+		require.Len(t, old.BricksIndex.Bricks, 1)
+	})
+
+	t.Run("the loaded version is tracked", func(t *testing.T) {
+		require.Empty(t, LoadedVersion(cfg))
+		registry.TrackLoadedVersion()
+		require.Equal(t, cfg.UsedPythonImageTag, LoadedVersion(cfg))
+		require.Contains(t, KeptVersions(cfg), cfg.UsedPythonImageTag)
+	})
+
+	t.Run("invalid assets are not loaded", func(t *testing.T) {
+		current := registry.Get()
+		require.NoError(t, dir.Join("bricks-list.yaml").WriteFile([]byte("bricks: [")))
+		_, err := registry.Reload()
+		require.Error(t, err)
+		require.Same(t, current, registry.Get())
+	})
+
+	t.Run("changes are detected by the watch", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		go registry.Watch(ctx, 10*time.Millisecond)
+
+		writeBricks("arduino:web_ui", "arduino:dbstorage_tsstore", "arduino:mqtt")
+		require.Eventually(t, func() bool {
+			return len(registry.Get().BricksIndex.Bricks) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,264 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package assets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/distribution/reference"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+var (
+	ErrVersionNotFound = errors.New("assets version not found")
+	ErrInvalidVersion  = errors.New("invalid assets version")
+)
+
+// provisionInfoFile is stored in each version of the assets by the provisioning.
+const provisionInfoFile = ".provision.json"
+
+// versionRegexp matches the valid docker tags, that are used as versions of the assets.
+var versionRegexp = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+
+type provisionInfo struct {
+	RunnerImage   string    `json:"runner_image"`
+	ProvisionedAt time.Time `json:"provisioned_at"`
+}
+
+// WriteProvisionInfo records in the assets folder the runner image used to provision it.
+func WriteProvisionInfo(dir *paths.Path, runnerImage string) error {
+	content, err := json.MarshalIndent(provisionInfo{RunnerImage: runnerImage, ProvisionedAt: time.Now().UTC()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return dir.Join(provisionInfoFile).WriteFile(content)
+}
+
+type Version struct {
+	Version       string     `json:"version"`
+	RunnerImage   string     `json:"runner_image"`
+	Size          int64      `json:"size"`
+	ProvisionedAt *time.Time `json:"provisioned_at,omitempty"`
+	InUse         bool       `json:"in_use"`
+	Pinned        bool       `json:"pinned"`
+	Default       bool       `json:"default"`
+}
+
+// ListVersions returns the provisioned versions of the assets.
+func ListVersions(cfg config.Configuration) ([]Version, error) {
+	dirs, err := cfg.AssetsDir().ReadDir(paths.FilterDirectories(), paths.FilterOutPrefixes(".", "dynamic-provisioning"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Version{}, nil
+		}
+		return nil, err
+	}
+	dirs.Sort()
+	pinned := PinnedVersion(cfg)
+	res := make([]Version, 0, len(dirs))
+	for _, dir := range dirs {
+		v := Version{
+			Version: dir.Base(),
+			InUse:   dir.Base() == cfg.UsedPythonImageTag,
+			Pinned:  dir.Base() == pinned,
+			Default: dir.Base() == cfg.RunnerVersion,
+		}
+		info := readProvisionInfo(cfg, v.Version)
+		v.RunnerImage = info.RunnerImage
+		if !info.ProvisionedAt.IsZero() {
+			v.ProvisionedAt = &info.ProvisionedAt
+		}
+		if v.Size, err = dirSize(dir); err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// PinnedVersion returns the version of the assets currently pinned, that is used from the
+// next start, or an empty string.
+func PinnedVersion(cfg config.Configuration) string {
+	content, err := cfg.PinnedAssetsFile().ReadFile()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
+// LoadedVersion returns the version of the assets loaded by the daemon, or an empty string.
+func LoadedVersion(cfg config.Configuration) string {
+	content, err := cfg.LoadedAssetsFile().ReadFile()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
+// KeptVersions returns the versions of the assets that must not be removed: the one in use,
+// the one loaded by the daemon, the pinned one and the one of the installed runner.
+func KeptVersions(cfg config.Configuration) []string {
+	kept := []string{cfg.UsedPythonImageTag}
+	for _, v := range []string{LoadedVersion(cfg), cfg.PinnedAssetsVersion, PinnedVersion(cfg), cfg.RunnerVersion} {
+		if v != "" && !slices.Contains(kept, v) {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// PinVersion selects the version of the assets, and of the runner, used from the next start.
+func PinVersion(cfg config.Configuration, version string) error {
+	if !ValidVersion(version) {
+		return fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+	if cfg.AssetsDir().Join(version).NotExist() {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, version)
+	}
+	return cfg.PinnedAssetsFile().WriteFile([]byte(version + "\n"))
+}
+
+// ValidVersion reports if the version can be used as the name of an assets folder.
+func ValidVersion(version string) bool {
+	return versionRegexp.MatchString(version)
+}
+
+// UnpinVersion restores the version of the assets of the installed runner.
+func UnpinVersion(cfg config.Configuration) error {
+	if err := cfg.PinnedAssetsFile().Remove(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+type PruneResult struct {
+	Removed    []string `json:"removed"`
+	SpaceFreed int64    `json:"space_freed"`
+}
+
+// provisioningGracePeriod is the time after which a provisioning folder not modified
+// anymore is considered the leftover of an interrupted provisioning.
+const provisioningGracePeriod = time.Hour
+
+// PruneVersions removes the versions of the assets that are not kept, together with the
+// leftovers of interrupted provisionings.
+func PruneVersions(cfg config.Configuration) (PruneResult, error) {
+	res := PruneResult{Removed: []string{}}
+	dirs, err := cfg.AssetsDir().ReadDir(paths.FilterDirectories())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return res, nil
+		}
+		return res, err
+	}
+	kept := KeptVersions(cfg)
+	for _, dir := range dirs {
+		name := dir.Base()
+		if slices.Contains(kept, name) || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if strings.HasPrefix(name, "dynamic-provisioning") {
+			modTime, err := lastModified(dir)
+			if err != nil || time.Since(modTime) < provisioningGracePeriod {
+				// The provisioning may still be running.
+				continue
+			}
+		}
+		size, err := dirSize(dir)
+		if err != nil {
+			slog.Warn("unable to compute the size of the assets", slog.String("path", dir.String()), slog.String("error", err.Error()))
+		}
+		if err := dir.RemoveAll(); err != nil {
+			return res, fmt.Errorf("cannot remove the assets %s: %w", name, err)
+		}
+		if !strings.HasPrefix(name, "dynamic-provisioning") {
+			res.Removed = append(res.Removed, name)
+		}
+		res.SpaceFreed += size
+	}
+	return res, nil
+}
+
+// RunnerImage returns the runner image used to provision a version of the assets.
+func RunnerImage(cfg config.Configuration, version string) string {
+	return readProvisionInfo(cfg, version).RunnerImage
+}
+
+func readProvisionInfo(cfg config.Configuration, version string) provisionInfo {
+	var info provisionInfo
+	if content, err := cfg.AssetsDir().Join(version, provisionInfoFile).ReadFile(); err == nil {
+		if err := json.Unmarshal(content, &info); err != nil {
+			slog.Warn("invalid provisioning info", slog.String("version", version), slog.String("error", err.Error()))
+		}
+	}
+	if info.RunnerImage == "" {
+		// The versions provisioned before the info was recorded used the configured runner image.
+		info.RunnerImage = strings.TrimSuffix(cfg.PythonImage, ":"+cfg.UsedPythonImageTag) + ":" + version
+		if named, err := reference.ParseNormalizedNamed(cfg.PythonImage); err == nil {
+			info.RunnerImage = reference.FamiliarName(named) + ":" + version
+		}
+	}
+	return info
+}
+
+// lastModified returns the last modification time of the folder or of its content.
+func lastModified(dir *paths.Path) (time.Time, error) {
+	var last time.Time
+	err := filepath.WalkDir(dir.String(), func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+		return nil
+	})
+	return last, err
+}
+
+func dirSize(dir *paths.Path) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir.String(), func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,92 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package assets
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func TestVersions(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	t.Setenv("ARDUINO_APP_BRICKS__CUSTOM_MODEL_DIR", tmp.Join("models").String())
+	newConfig := func() config.Configuration {
+		cfg, err := config.NewFromEnv()
+		require.NoError(t, err)
+		return cfg
+	}
+	cfg := newConfig()
+
+	for _, version := range []string{"0.3.0", "0.4.0", cfg.RunnerVersion, "0.6.0-dev", "dynamic-provisioning123", "dynamic-provisioning456"} {
+		dir := cfg.AssetsDir().Join(version)
+		require.NoError(t, dir.MkdirAll())
+		require.NoError(t, dir.Join("bricks-list.yaml").WriteFile([]byte("bricks: []\n")))
+	}
+	// An interrupted provisioning, while the other one is still running.
+	stale := time.Now().Add(-2 * provisioningGracePeriod)
+	for _, p := range []string{"bricks-list.yaml", ""} {
+		require.NoError(t, os.Chtimes(cfg.AssetsDir().Join("dynamic-provisioning123", p).String(), stale, stale))
+	}
+	require.NoError(t, WriteProvisionInfo(cfg.AssetsDir().Join("0.4.0"), "ghcr.io/arduino/app-bricks/python-apps-base:0.4.0"))
+
+	versions, err := ListVersions(cfg)
+	require.NoError(t, err)
+	require.Len(t, versions, 4)
+	require.Equal(t, "0.4.0", versions[1].Version)
+	require.Equal(t, "ghcr.io/arduino/app-bricks/python-apps-base:0.4.0", versions[1].RunnerImage)
+	require.NotNil(t, versions[1].ProvisionedAt)
+	require.Equal(t, cfg.RunnerVersion, versions[2].Version)
+	require.True(t, versions[2].InUse)
+	require.True(t, versions[2].Default)
+	require.Equal(t, cfg.PythonImage, versions[2].RunnerImage)
+	require.Positive(t, versions[2].Size)
+
+	require.ErrorIs(t, PinVersion(cfg, "1.0.0"), ErrVersionNotFound)
+	require.ErrorIs(t, PinVersion(cfg, "../0.4.0"), ErrInvalidVersion)
+	require.NoError(t, PinVersion(cfg, "0.4.0"))
+	require.Equal(t, "0.4.0", PinnedVersion(cfg))
+
+	// The pinned version is used from the next start.
+	pinned := newConfig()
+	require.Equal(t, "0.4.0", pinned.UsedPythonImageTag)
+	require.Equal(t, "0.4.0", pinned.PinnedAssetsVersion)
+	require.Equal(t, "ghcr.io/arduino/app-bricks/python-apps-base:0.4.0", pinned.PythonImage)
+
+	// The daemon still serves the version it loaded at start.
+	require.NoError(t, cfg.LoadedAssetsFile().WriteFile([]byte("0.3.0\n")))
+	require.Equal(t, "0.3.0", LoadedVersion(cfg))
+
+	res, err := PruneVersions(cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.6.0-dev"}, res.Removed)
+	require.True(t, cfg.AssetsDir().Join("0.3.0").Exist())
+	require.True(t, cfg.AssetsDir().Join("0.4.0").Exist())
+	require.True(t, cfg.AssetsDir().Join(cfg.RunnerVersion).Exist())
+	require.False(t, cfg.AssetsDir().Join("dynamic-provisioning123").Exist())
+	require.True(t, cfg.AssetsDir().Join("dynamic-provisioning456").Exist())
+
+	require.NoError(t, UnpinVersion(cfg))
+	require.Empty(t, PinnedVersion(cfg))
+	require.Equal(t, cfg.RunnerVersion, newConfig().UsedPythonImageTag)
+}
@@ -60,10 +60,10 @@ func (s *Service) List() (BrickListResult, error) {
 		res.Bricks[i] = BrickListItem{
 			ID:          brick.ID,
 			Name:        brick.Name,
-			Author:      "Arduino", // TODO: for now we only support our bricks
+			Author:      brick.GetAuthor(),
 			Description: brick.Description,
 			Category:    brick.Category,
-			Status:      "installed",
+			Status:      brick.GetStatus(),
 			Models: f.Map(s.modelsIndex.GetModelsByBrick(brick.ID), func(m modelsindex.AIModel) string {
 				return m.ID
 			}),
@@ -85,9 +85,9 @@ func (s *Service) AppBrickInstancesList(a *app.ArduinoApp) (AppBrickInstancesRes
 		res.BrickInstances[i] = BrickInstance{
 			ID:              brick.ID,
 			Name:            brick.Name,
-			Author:          "Arduino", // TODO: for now we only support our bricks
+			Author:          brick.GetAuthor(),
 			Category:        brick.Category,
-			Status:          "installed",
+			Status:          brick.GetStatus(),
 			ModelID:         brickInstance.Model, // TODO: in case is not set by the user, should we return the default model?
 			Variables:       variablesMap,        // TODO: do we want to show also the default value of not explicitly set variables?
 			ConfigVariables: configVariables,
@@ -118,9 +118,9 @@ func (s *Service) AppBrickInstanceDetails(a *app.ArduinoApp, brickID string) (Br
 	return BrickInstance{
 		ID:              brickID,
 		Name:            brick.Name,
-		Author:          "Arduino", // TODO: for now we only support our bricks
+		Author:          brick.GetAuthor(),
 		Category:        brick.Category,
-		Status:          "installed", // For now every Arduino brick are installed
+		Status:          brick.GetStatus(),
 		Variables:       variables,
 		ConfigVariables: configVariables,
 		ModelID:         modelID,
@@ -147,12 +147,46 @@ func getBrickConfigDetails(
 			Value:       finalValue,
 			Description: v.Description,
 			Required:    v.IsRequired(),
+			Type:        string(v.GetType()),
+			Min:         v.Min,
+			Max:         v.Max,
+			Pattern:     v.Pattern,
+			Choices:     v.Choices,
 		})
 	}
 
 	return variablesMap, variableDetails
 }
 
+// validateBrickVariables checks the values of the variables against their definition in the
+// brick, collecting an error for each invalid variable. If checkMissing is set, the required
+// variables missing from values are reported too.
+func validateBrickVariables(brick *bricksindex.Brick, values map[string]string, checkMissing bool) error {
+	var errs []VariableError
+	for _, v := range brick.Variables {
+		value, found := values[v.Name]
+		if !found {
+			if checkMissing && v.IsRequired() && v.DefaultValue == "" {
+				errs = append(errs, VariableError{Name: v.Name, Message: fmt.Sprintf("required variable %q is mandatory", v.Name)})
+			}
+			continue
+		}
+		if value == "" {
+			if v.IsRequired() && v.DefaultValue == "" {
+				errs = append(errs, VariableError{Name: v.Name, Message: fmt.Sprintf("variable %q cannot be empty", v.Name)})
+			}
+			continue
+		}
+		if err := v.Validate(value); err != nil {
+			errs = append(errs, VariableError{Name: v.Name, Message: fmt.Sprintf("variable %q %s", v.Name, err)})
+		}
+	}
+	if len(errs) > 0 {
+		return &InvalidVariablesError{Errors: errs}
+	}
+	return nil
+}
+
 func (s *Service) BricksDetails(id string, idProvider *app.IDProvider,
 	cfg config.Configuration) (BrickDetailsResult, error) {
 	brick, found := s.bricksIndex.FindBrickByID(id)
@@ -166,6 +200,11 @@ func (s *Service) BricksDetails(id string, idProvider *app.IDProvider,
 			DefaultValue: v.DefaultValue,
 			Description:  v.Description,
 			Required:     v.IsRequired(),
+			Type:         string(v.GetType()),
+			Min:          v.Min,
+			Max:          v.Max,
+			Pattern:      v.Pattern,
+			Choices:      v.Choices,
 		}
 	}
 
@@ -197,10 +236,10 @@ func (s *Service) BricksDetails(id string, idProvider *app.IDProvider,
 	return BrickDetailsResult{
 		ID:           id,
 		Name:         brick.Name,
-		Author:       "Arduino", // TODO: for now we only support our bricks
+		Author:       brick.GetAuthor(),
 		Description:  brick.Description,
 		Category:     brick.Category,
-		Status:       "installed", // For now every Arduino brick are installed
+		Status:       brick.GetStatus(),
 		Variables:    variables,
 		Readme:       readme,
 		ApiDocsPath:  apiDocsPath,
@@ -277,23 +316,36 @@ func (s *Service) BrickCreate(
 		return fmt.Errorf("brick %q not found", req.ID)
 	}
 
-	for name, reqValue := range req.Variables {
-		value, exist := brick.GetVariable(name)
-		if !exist {
+	for name := range req.Variables {
+		if _, exist := brick.GetVariable(name); !exist {
 			return fmt.Errorf("variable %q does not exist on brick %q", name, brick.ID)
 		}
-		if value.DefaultValue == "" && reqValue == "" {
-			return fmt.Errorf("variable %q cannot be empty", name)
-		}
+	}
+	if err := validateBrickVariables(brick, req.Variables, true); err != nil {
+		return err
 	}
 
-	for _, brickVar := range brick.Variables {
-		if brickVar.DefaultValue == "" {
-			if _, exist := req.Variables[brickVar.Name]; !exist {
-				return fmt.Errorf("required variable %q is mandatory", brickVar.Name)
+	bricks := []string{req.ID}
+	for _, b := range appCurrent.Descriptor.Bricks {
+		if b.ID != req.ID {
+			bricks = append(bricks, b.ID)
+		}
+	}
+	dependencies, err := s.bricksIndex.MissingDependencies(bricks)
+	if err != nil {
+		return err
+	}
+	for _, dep := range dependencies {
+		depBrick, _ := s.bricksIndex.FindBrickByID(dep)
+		for _, v := range depBrick.Variables {
+			if v.IsRequired() {
+				return fmt.Errorf("brick %q requires the brick %q, add it first to set its required variable %q", req.ID, dep, v.Name)
 			}
 		}
 	}
+	if err := s.bricksIndex.CheckConflicts(append(bricks, dependencies...)); err != nil {
+		return err
+	}
 
 	brickIndex := -1
 	var brickInstance app.Brick
@@ -314,6 +366,9 @@ func (s *Service) BrickCreate(
 		if idx == -1 {
 			return fmt.Errorf("model %s does not exsist", *req.Model)
 		}
+		if err := modelsindex.CheckBrickCompatibility(models[idx], brick); err != nil {
+			return err
+		}
 		brickInstance.Model = models[idx].ID
 	}
 	brickInstance.Variables = req.Variables
@@ -323,8 +378,12 @@ func (s *Service) BrickCreate(
 	} else {
 		appCurrent.Descriptor.Bricks[brickIndex] = brickInstance
 	}
+	// The dependencies are added with their default configuration.
+	for _, dep := range dependencies {
+		appCurrent.Descriptor.Bricks = append(appCurrent.Descriptor.Bricks, app.Brick{ID: dep})
+	}
 
-	err := appCurrent.Save()
+	err = appCurrent.Save()
 	if err != nil {
 		return fmt.Errorf("cannot save brick instance with id %s", req.ID)
 	}
@@ -346,26 +405,30 @@ func (s *Service) BrickUpdate(
 	}
 	brickModel := appCurrent.Descriptor.Bricks[index].Model
 
+	brick, present := s.bricksIndex.FindBrickByID(brickID)
+	if !present {
+		return fmt.Errorf("brick not found with id %s", brickID)
+	}
 	if req.Model != nil && *req.Model != brickModel {
 		models := s.modelsIndex.GetModelsByBrick(req.ID)
 		idx := slices.IndexFunc(models, func(m modelsindex.AIModel) bool { return m.ID == *req.Model })
 		if idx == -1 {
 			return fmt.Errorf("model %s does not exsist", *req.Model)
 		}
+		if err := modelsindex.CheckBrickCompatibility(models[idx], brick); err != nil {
+			return err
+		}
 		brickModel = *req.Model
 	}
-	brick, present := s.bricksIndex.FindBrickByID(brickID)
-	if !present {
-		return fmt.Errorf("brick not found with id %s", brickID)
-	}
-	for name, updateValue := range req.Variables {
-		value, exist := brick.GetVariable(name)
-		if !exist {
+	for name := range req.Variables {
+		if _, exist := brick.GetVariable(name); !exist {
 			return errors.New("variable does not exist")
 		}
-		if value.DefaultValue == "" && updateValue == "" {
-			return errors.New("variable default value cannot be empty")
-		}
+	}
+	if err := validateBrickVariables(brick, req.Variables, false); err != nil {
+		return err
+	}
+	for name, updateValue := range req.Variables {
 		updated := false
 		for _, v := range brickVariables {
 			if v == name {
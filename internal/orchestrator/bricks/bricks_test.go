@@ -111,6 +111,101 @@ func TestBrickCreate(t *testing.T) {
 	})
 }
 
+func TestBrickVariablesValidation(t *testing.T) {
+	bricksIndex := &bricksindex.BricksIndex{Bricks: []bricksindex.Brick{
+		{ID: "arduino:arduino_cloud"},
+		{ID: "arduino:mqtt", Variables: []bricksindex.BrickVariable{
+			{Name: "BROKER_URL", Type: bricksindex.VariableTypeURL},
+			{Name: "BROKER_PORT", DefaultValue: "1883", Type: bricksindex.VariableTypeInt, Min: f.Ptr(1.0), Max: f.Ptr(65535.0)},
+			{Name: "QOS", DefaultValue: "0", Type: bricksindex.VariableTypeEnum, Choices: []string{"0", "1", "2"}},
+		}},
+	}}
+	brickService := NewService(nil, bricksIndex, nil)
+	dir := paths.New(t.TempDir(), "dummy-app")
+	require.NoError(t, paths.New("testdata/dummy-app").CopyDirTo(dir))
+
+	err := brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:mqtt", Variables: map[string]string{
+		"BROKER_PORT": "70000",
+		"QOS":         "3",
+	}}, f.Must(app.Load(dir.String())))
+	var invalidVariables *InvalidVariablesError
+	require.ErrorAs(t, err, &invalidVariables)
+	require.Equal(t, []VariableError{
+		{Name: "BROKER_URL", Message: `required variable "BROKER_URL" is mandatory`},
+		{Name: "BROKER_PORT", Message: `variable "BROKER_PORT" must be less than or equal to 65535`},
+		{Name: "QOS", Message: `variable "QOS" must be one of [0 1 2]`},
+	}, invalidVariables.Errors)
+
+	require.NoError(t, brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:mqtt", Variables: map[string]string{
+		"BROKER_URL": "mqtt://broker.local",
+	}}, f.Must(app.Load(dir.String()))))
+
+	err = brickService.BrickUpdate(BrickCreateUpdateRequest{ID: "arduino:mqtt", Variables: map[string]string{
+		"BROKER_URL": "broker.local",
+	}}, f.Must(app.Load(dir.String())))
+	require.EqualError(t, err, `variable "BROKER_URL" must be a valid URL`)
+
+	details, err := brickService.AppBrickInstanceDetails(f.Ptr(f.Must(app.Load(dir.String()))), "arduino:mqtt")
+	require.NoError(t, err)
+	require.Equal(t, "int", details.ConfigVariables[1].Type)
+	require.Equal(t, 65535.0, *details.ConfigVariables[1].Max)
+	require.Equal(t, []string{"0", "1", "2"}, details.ConfigVariables[2].Choices)
+}
+
+func TestBrickCreateDependenciesAndConflicts(t *testing.T) {
+	bricksIndex := &bricksindex.BricksIndex{Bricks: []bricksindex.Brick{
+		{ID: "arduino:arduino_cloud"},
+		{ID: "arduino:usb_camera", ExclusiveDevices: []string{"camera"}},
+		{ID: "arduino:video_objectdetection", DependsOn: []string{"arduino:usb_camera"}},
+		{ID: "arduino:camera_snapshot", ExclusiveDevices: []string{"camera"}},
+		{ID: "arduino:web_ui", Ports: []string{"7000"}},
+		{ID: "arduino:streamlit_ui", Ports: []string{"7000"}},
+		{ID: "arduino:cloud_mirror", ConflictsWith: []string{"arduino:arduino_cloud"}},
+		{ID: "arduino:mqtt", Variables: []bricksindex.BrickVariable{{Name: "BROKER"}}},
+		{ID: "arduino:telemetry", DependsOn: []string{"arduino:mqtt"}},
+	}}
+	brickService := NewService(nil, bricksIndex, nil)
+	loadApp := func(t *testing.T) app.ArduinoApp {
+		dir := paths.New(t.TempDir(), "dummy-app")
+		require.NoError(t, paths.New("testdata/dummy-app").CopyDirTo(dir))
+		return f.Must(app.Load(dir.String()))
+	}
+
+	t.Run("dependencies are added", func(t *testing.T) {
+		a := loadApp(t)
+		require.NoError(t, brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:video_objectdetection"}, a))
+		after := f.Must(app.Load(a.FullPath.String()))
+		require.Equal(t,
+			[]string{"arduino:arduino_cloud", "arduino:video_objectdetection", "arduino:usb_camera"},
+			f.Map(after.Descriptor.Bricks, func(b app.Brick) string { return b.ID }),
+		)
+	})
+
+	t.Run("dependencies with required variables must be added first", func(t *testing.T) {
+		err := brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:telemetry"}, loadApp(t))
+		require.EqualError(t, err, `brick "arduino:telemetry" requires the brick "arduino:mqtt", add it first to set its required variable "BROKER"`)
+	})
+
+	t.Run("declared conflicts are refused", func(t *testing.T) {
+		err := brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:cloud_mirror"}, loadApp(t))
+		require.ErrorIs(t, err, bricksindex.ErrBrickConflict)
+	})
+
+	t.Run("bricks binding the same port are refused", func(t *testing.T) {
+		a := loadApp(t)
+		require.NoError(t, brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:web_ui"}, a))
+		err := brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:streamlit_ui"}, f.Must(app.Load(a.FullPath.String())))
+		require.EqualError(t, err, `bricks conflict: "arduino:streamlit_ui" and "arduino:web_ui" both use the port 7000`)
+	})
+
+	t.Run("bricks requiring the same exclusive device are refused", func(t *testing.T) {
+		a := loadApp(t)
+		require.NoError(t, brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:video_objectdetection"}, a))
+		err := brickService.BrickCreate(BrickCreateUpdateRequest{ID: "arduino:camera_snapshot"}, f.Must(app.Load(a.FullPath.String())))
+		require.EqualError(t, err, `bricks conflict: "arduino:camera_snapshot" and "arduino:usb_camera" both require the exclusive access to the camera device`)
+	})
+}
+
 func TestGetBrickInstanceVariableDetails(t *testing.T) {
 	tests := []struct {
 		name                    string
@@ -128,7 +223,7 @@ func TestGetBrickInstanceVariableDetails(t *testing.T) {
 			},
 			userVariables: map[string]string{"VAR1": "value1"},
 			expectedConfigVariables: []BrickConfigVariable{
-				{Name: "VAR1", Value: "value1", Description: "desc", Required: true},
+				{Name: "VAR1", Value: "value1", Description: "desc", Required: true, Type: "string"},
 			},
 			expectedVariableMap: map[string]string{"VAR1": "value1"},
 		},
@@ -141,7 +236,7 @@ func TestGetBrickInstanceVariableDetails(t *testing.T) {
 			},
 			userVariables: map[string]string{},
 			expectedConfigVariables: []BrickConfigVariable{
-				{Name: "VAR1", Value: "", Description: "desc", Required: true},
+				{Name: "VAR1", Value: "", Description: "desc", Required: true, Type: "string"},
 			},
 			expectedVariableMap: map[string]string{"VAR1": ""},
 		},
@@ -154,7 +249,7 @@ func TestGetBrickInstanceVariableDetails(t *testing.T) {
 			},
 			userVariables: map[string]string{},
 			expectedConfigVariables: []BrickConfigVariable{
-				{Name: "VAR1", Value: "default", Description: "desc", Required: false},
+				{Name: "VAR1", Value: "default", Description: "desc", Required: false, Type: "string"},
 			},
 			expectedVariableMap: map[string]string{"VAR1": "default"},
 		},
@@ -168,8 +263,8 @@ func TestGetBrickInstanceVariableDetails(t *testing.T) {
 			},
 			userVariables: map[string]string{"VAR1": "v1"},
 			expectedConfigVariables: []BrickConfigVariable{
-				{Name: "VAR1", Value: "v1", Description: "desc1", Required: true},
-				{Name: "VAR2", Value: "def2", Description: "desc2", Required: false},
+				{Name: "VAR1", Value: "v1", Description: "desc1", Required: true, Type: "string"},
+				{Name: "VAR2", Value: "def2", Description: "desc2", Required: false, Type: "string"},
 			},
 			expectedVariableMap: map[string]string{"VAR1": "v1", "VAR2": "def2"},
 		},
@@ -15,6 +15,8 @@
 
 package bricks
 
+import "strings"
+
 type BrickListResult struct {
 	Bricks []BrickListItem `json:"bricks"`
 }
@@ -45,16 +47,45 @@ type BrickInstance struct {
 }
 
 type BrickConfigVariable struct {
-	Name        string `json:"name"`
-	Value       string `json:"value"`
-	Description string `json:"description"`
-	Required    bool   `json:"required"`
+	Name        string   `json:"name"`
+	Value       string   `json:"value"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Type        string   `json:"type"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	Choices     []string `json:"choices,omitempty"`
 }
 
 type BrickVariable struct {
-	DefaultValue string `json:"default_value,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Required     bool   `json:"required"`
+	DefaultValue string   `json:"default_value,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Required     bool     `json:"required"`
+	Type         string   `json:"type"`
+	Min          *float64 `json:"min,omitempty"`
+	Max          *float64 `json:"max,omitempty"`
+	Pattern      string   `json:"pattern,omitempty"`
+	Choices      []string `json:"choices,omitempty"`
+}
+
+// VariableError reports an invalid value of a brick variable.
+type VariableError struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// InvalidVariablesError is returned when some of the variables of a brick are not valid.
+type InvalidVariablesError struct {
+	Errors []VariableError `json:"errors"`
+}
+
+func (e *InvalidVariablesError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Message
+	}
+	return strings.Join(msgs, "; ")
 }
 
 type CodeExample struct {
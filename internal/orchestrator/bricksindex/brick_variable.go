@@ -0,0 +1,150 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bricksindex
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/secrets"
+)
+
+type VariableType string
+
+const (
+	VariableTypeString VariableType = "string"
+	VariableTypeInt    VariableType = "int"
+	VariableTypeFloat  VariableType = "float"
+	VariableTypeBool   VariableType = "bool"
+	VariableTypeEnum   VariableType = "enum"
+	VariableTypePath   VariableType = "path"
+	VariableTypeURL    VariableType = "url"
+	VariableTypeSecret VariableType = "secret"
+)
+
+var VariableTypes = []VariableType{
+	VariableTypeString,
+	VariableTypeInt,
+	VariableTypeFloat,
+	VariableTypeBool,
+	VariableTypeEnum,
+	VariableTypePath,
+	VariableTypeURL,
+	VariableTypeSecret,
+}
+
+type BrickVariable struct {
+	Name         string       `yaml:"name"`
+	DefaultValue string       `yaml:"default_value"`
+	Description  string       `yaml:"description,omitempty"`
+	Type         VariableType `yaml:"type,omitempty"`
+	// Required overrides the default behaviour, where a variable is required if it has no default value.
+	Required *bool    `yaml:"required,omitempty"`
+	Min      *float64 `yaml:"min,omitempty"`
+	Max      *float64 `yaml:"max,omitempty"`
+	Pattern  string   `yaml:"pattern,omitempty"`
+	Choices  []string `yaml:"choices,omitempty"`
+}
+
+func (v BrickVariable) IsRequired() bool {
+	if v.Required != nil {
+		return *v.Required
+	}
+	return v.DefaultValue == ""
+}
+
+// GetType returns the type of the variable, string if it is not set.
+func (v BrickVariable) GetType() VariableType {
+	if v.Type == "" {
+		return VariableTypeString
+	}
+	return v.Type
+}
+
+// Validate checks that the value is compatible with the type and the constraints of the variable.
+// An empty value, meaning that the default one is used, is always valid: the required variables
+// must be checked by the caller. Values referencing a secret are checked only once resolved.
+func (v BrickVariable) Validate(value string) error {
+	if value == "" || len(secrets.References(value)) > 0 {
+		return nil
+	}
+
+	switch v.GetType() {
+	case VariableTypeInt:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.New("must be an integer")
+		}
+		if err := v.checkRange(float64(n)); err != nil {
+			return err
+		}
+	case VariableTypeFloat:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.New("must be a number")
+		}
+		if err := v.checkRange(n); err != nil {
+			return err
+		}
+	case VariableTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be true or false")
+		}
+	case VariableTypeEnum:
+		if !slices.Contains(v.Choices, value) {
+			return fmt.Errorf("must be one of %v", v.Choices)
+		}
+	case VariableTypePath:
+		// Paths are resolved inside the containers.
+		if !path.IsAbs(value) {
+			return errors.New("must be an absolute path")
+		}
+	case VariableTypeURL:
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("must be a valid URL")
+		}
+	case VariableTypeString, VariableTypeSecret:
+	default:
+		return fmt.Errorf("unknown variable type %q", v.Type)
+	}
+
+	if v.Pattern != "" {
+		re, err := regexp.Compile("^(?:" + v.Pattern + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", v.Pattern, err)
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("must match the pattern %q", v.Pattern)
+		}
+	}
+	return nil
+}
+
+func (v BrickVariable) checkRange(n float64) error {
+	if v.Min != nil && n < *v.Min {
+		return fmt.Errorf("must be greater than or equal to %v", *v.Min)
+	}
+	if v.Max != nil && n > *v.Max {
+		return fmt.Errorf("must be less than or equal to %v", *v.Max)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bricksindex
+
+import (
+	"testing"
+
+	yaml "github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+)
+
+func TestBrickVariableValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		variable BrickVariable
+		value    string
+		err      string
+	}{
+		{"untyped", BrickVariable{}, "anything", ""},
+		{"empty value", BrickVariable{Type: VariableTypeInt}, "", ""},
+		{"secret reference", BrickVariable{Type: VariableTypeInt}, "${secret:port}", ""},
+		{"int", BrickVariable{Type: VariableTypeInt}, "42", ""},
+		{"int invalid", BrickVariable{Type: VariableTypeInt}, "4.2", "must be an integer"},
+		{"int below min", BrickVariable{Type: VariableTypeInt, Min: f.Ptr(1.0)}, "0", "must be greater than or equal to 1"},
+		{"int above max", BrickVariable{Type: VariableTypeInt, Max: f.Ptr(65535.0)}, "70000", "must be less than or equal to 65535"},
+		{"float", BrickVariable{Type: VariableTypeFloat, Min: f.Ptr(0.0), Max: f.Ptr(1.0)}, "0.5", ""},
+		{"float invalid", BrickVariable{Type: VariableTypeFloat}, "half", "must be a number"},
+		{"bool", BrickVariable{Type: VariableTypeBool}, "true", ""},
+		{"bool invalid", BrickVariable{Type: VariableTypeBool}, "yes please", "must be true or false"},
+		{"enum", BrickVariable{Type: VariableTypeEnum, Choices: []string{"low", "high"}}, "low", ""},
+		{"enum invalid", BrickVariable{Type: VariableTypeEnum, Choices: []string{"low", "high"}}, "medium", "must be one of [low high]"},
+		{"path", BrickVariable{Type: VariableTypePath}, "/opt/models/ei/", ""},
+		{"path relative", BrickVariable{Type: VariableTypePath}, "models", "must be an absolute path"},
+		{"url", BrickVariable{Type: VariableTypeURL}, "mqtt://broker.local:1883", ""},
+		{"url invalid", BrickVariable{Type: VariableTypeURL}, "broker.local", "must be a valid URL"},
+		{"secret", BrickVariable{Type: VariableTypeSecret}, "s3cr3t", ""},
+		{"pattern", BrickVariable{Pattern: "[a-z]+"}, "abc", ""},
+		{"pattern not matching the whole value", BrickVariable{Pattern: "[a-z]+"}, "abc1", `must match the pattern "[a-z]+"`},
+		{"unknown type", BrickVariable{Type: "color"}, "red", `unknown variable type "color"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.variable.Validate(tc.value)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestBrickVariableSchema(t *testing.T) {
+	var v BrickVariable
+	require.NoError(t, yaml.Unmarshal([]byte(`
+name: PORT
+default_value: "8080"
+type: int
+required: true
+min: 1
+max: 65535
+`), &v))
+	require.Equal(t, VariableTypeInt, v.GetType())
+	require.True(t, v.IsRequired())
+	require.Equal(t, 1.0, *v.Min)
+	require.Equal(t, 65535.0, *v.Max)
+
+	require.Equal(t, VariableTypeString, BrickVariable{}.GetType())
+	require.True(t, BrickVariable{}.IsRequired())
+	require.False(t, BrickVariable{Required: f.Ptr(false)}.IsRequired())
+}
@@ -16,14 +16,29 @@
 package bricksindex
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"iter"
+	"log/slog"
 	"slices"
+	"strings"
 
 	"github.com/arduino/go-paths-helper"
 	yaml "github.com/goccy/go-yaml"
 )
 
+// ArduinoNamespace is the namespace of the bricks shipped with the assets,
+// it cannot be used by the custom bricks.
+const ArduinoNamespace = "arduino"
+
+var ErrBrickConflict = errors.New("bricks conflict")
+
+const (
+	BrickStatusInstalled = "installed"
+	BrickStatusCustom    = "custom"
+)
+
 type BricksIndex struct {
 	Bricks []Brick `yaml:"bricks"`
 }
@@ -38,16 +53,6 @@ func (b *BricksIndex) FindBrickByID(id string) (*Brick, bool) {
 	return &b.Bricks[idx], true
 }
 
-type BrickVariable struct {
-	Name         string `yaml:"name"`
-	DefaultValue string `yaml:"default_value"`
-	Description  string `yaml:"description,omitempty"`
-}
-
-func (v BrickVariable) IsRequired() bool {
-	return v.DefaultValue == ""
-}
-
 type Brick struct {
 	ID                        string          `yaml:"id"`
 	Name                      string          `yaml:"name"`
@@ -59,8 +64,126 @@ type Brick struct {
 	Variables                 []BrickVariable `yaml:"variables,omitempty"`
 	Ports                     []string        `yaml:"ports,omitempty"`
 	ModelName                 string          `yaml:"model_name,omitempty"`
+	ModelSensor               string          `yaml:"model_sensor,omitempty"`
+	ModelType                 string          `yaml:"model_type,omitempty"`
 	MountDevicesIntoContainer bool            `yaml:"mount_devices_into_container,omitempty"`
 	RequiredDevices           []string        `yaml:"required_devices,omitempty"`
+	Author                    string          `yaml:"author,omitempty"`
+	DependsOn                 []string        `yaml:"depends_on,omitempty"`
+	ConflictsWith             []string        `yaml:"conflicts_with,omitempty"`
+	ExclusiveDevices          []string        `yaml:"exclusive_devices,omitempty"`
+
+	// Dir is the folder of a custom brick, it is nil for the bricks shipped with the assets.
+	Dir *paths.Path `yaml:"-"`
+}
+
+func (b Brick) IsCustom() bool {
+	return b.Dir != nil
+}
+
+func (b Brick) GetAuthor() string {
+	if b.Author != "" {
+		return b.Author
+	}
+	if b.IsCustom() {
+		namespace, _, _ := strings.Cut(b.ID, ":")
+		return namespace
+	}
+	return "Arduino"
+}
+
+func (b Brick) GetStatus() string {
+	if b.IsCustom() {
+		return BrickStatusCustom
+	}
+	return BrickStatusInstalled
+}
+
+// GetModelSensor returns the sensor providing the input of the model of the brick. If it is
+// not declared, it is guessed from the required devices and the category of the brick.
+func (b Brick) GetModelSensor() string {
+	if b.ModelSensor != "" {
+		return b.ModelSensor
+	}
+	switch {
+	case slices.Contains(b.RequiredDevices, "camera"):
+		return "camera"
+	case slices.Contains(b.RequiredDevices, "microphone"):
+		return "microphone"
+	}
+	switch b.Category {
+	case "video", "image":
+		return "camera"
+	case "audio":
+		return "microphone"
+	}
+	return ""
+}
+
+// MissingDependencies returns the bricks required, directly or indirectly, by the given
+// bricks that are not part of ids. Bricks that are not in the index are ignored.
+func (b *BricksIndex) MissingDependencies(ids []string) ([]string, error) {
+	var missing []string
+	visited := make(map[string]bool, len(ids))
+	var visit func(id string) error
+	visit = func(id string) error {
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+		brick, found := b.FindBrickByID(id)
+		if !found {
+			return nil
+		}
+		for _, dep := range brick.DependsOn {
+			if _, found := b.FindBrickByID(dep); !found {
+				return fmt.Errorf("brick %q requires the brick %q that does not exist", id, dep)
+			}
+			if !slices.Contains(ids, dep) && !slices.Contains(missing, dep) {
+				missing = append(missing, dep)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, id := range ids {
+		if err := visit(id); err != nil {
+			return nil, err
+		}
+	}
+	return missing, nil
+}
+
+// CheckConflicts returns an error wrapping ErrBrickConflict if the given bricks cannot
+// be used together, because they declare a conflict, bind the same port or require the
+// exclusive access to the same device. Bricks that are not in the index are ignored.
+func (b *BricksIndex) CheckConflicts(ids []string) error {
+	var bricks []*Brick
+	for _, id := range ids {
+		if brick, found := b.FindBrickByID(id); found {
+			bricks = append(bricks, brick)
+		}
+	}
+	for i, x := range bricks {
+		for _, y := range bricks[i+1:] {
+			if slices.Contains(x.ConflictsWith, y.ID) || slices.Contains(y.ConflictsWith, x.ID) {
+				return fmt.Errorf("%w: %q cannot be used together with %q", ErrBrickConflict, x.ID, y.ID)
+			}
+			for _, p := range x.Ports {
+				if slices.Contains(y.Ports, p) {
+					return fmt.Errorf("%w: %q and %q both use the port %s", ErrBrickConflict, x.ID, y.ID, p)
+				}
+			}
+			for _, d := range x.ExclusiveDevices {
+				if slices.Contains(y.ExclusiveDevices, d) {
+					return fmt.Errorf("%w: %q and %q both require the exclusive access to the %s device", ErrBrickConflict, x.ID, y.ID, d)
+				}
+			}
+		}
+	}
+	return nil
 }
 
 func (b Brick) GetVariable(name string) (BrickVariable, bool) {
@@ -99,3 +222,65 @@ func GenerateBricksIndexFromFile(dir *paths.Path) (*BricksIndex, error) {
 	defer content.Close()
 	return unmarshalBricksIndex(content)
 }
+
+// AddCustomBricks adds to the index the bricks authored by the user. Each brick is stored
+// in the <namespace>/<name> folder of dir and it is registered with the <namespace>:<name> ID.
+// Invalid bricks are skipped.
+func (b *BricksIndex) AddCustomBricks(dir *paths.Path) error {
+	if dir == nil || dir.NotExist() {
+		return nil
+	}
+	namespaces, err := dir.ReadDir(paths.FilterDirectories(), paths.FilterOutPrefixes("."))
+	if err != nil {
+		return fmt.Errorf("cannot read custom bricks directory %q: %w", dir, err)
+	}
+	for _, namespace := range namespaces {
+		if namespace.Base() == ArduinoNamespace {
+			slog.Warn("the arduino namespace is reserved, skipping custom bricks", slog.String("path", namespace.String()))
+			continue
+		}
+		brickDirs, err := namespace.ReadDir(paths.FilterDirectories(), paths.FilterOutPrefixes("."))
+		if err != nil {
+			return fmt.Errorf("cannot read custom bricks directory %q: %w", namespace, err)
+		}
+		for _, brickDir := range brickDirs {
+			brick, err := loadCustomBrick(namespace.Base(), brickDir)
+			if err != nil {
+				slog.Warn("invalid custom brick, skipping", slog.String("path", brickDir.String()), slog.String("error", err.Error()))
+				continue
+			}
+			if _, found := b.FindBrickByID(brick.ID); found {
+				slog.Warn("brick already exists, skipping", slog.String("brick_id", brick.ID), slog.String("path", brickDir.String()))
+				continue
+			}
+			b.Bricks = append(b.Bricks, *brick)
+		}
+	}
+	return nil
+}
+
+func loadCustomBrick(namespace string, dir *paths.Path) (*Brick, error) {
+	content, err := dir.Join("brick.yaml").ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var brick Brick
+	if err := yaml.Unmarshal(content, &brick); err != nil {
+		return nil, fmt.Errorf("cannot parse brick.yaml: %w", err)
+	}
+
+	id := namespace + ":" + dir.Base()
+	if brick.ID == "" {
+		brick.ID = id
+	} else if brick.ID != id {
+		return nil, fmt.Errorf("brick id %q does not match its folder, expected %q", brick.ID, id)
+	}
+	if brick.Name == "" {
+		brick.Name = dir.Base()
+	}
+	if brick.RequireContainer && dir.Join("brick_compose.yaml").NotExist() {
+		return nil, errors.New("brick requires a container but brick_compose.yaml is missing")
+	}
+	brick.Dir = dir
+	return &brick, nil
+}
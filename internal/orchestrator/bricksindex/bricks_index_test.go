@@ -18,6 +18,7 @@ package bricksindex
 import (
 	"testing"
 
+	"github.com/arduino/go-paths-helper"
 	yaml "github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/require"
 )
@@ -184,3 +185,76 @@ func TestBricksIndex(t *testing.T) {
 	require.False(t, b.Variables[0].IsRequired())
 	require.False(t, b.Variables[1].IsRequired())
 }
+
+func TestAddCustomBricks(t *testing.T) {
+	dir := paths.New(t.TempDir())
+	writeBrick := func(namespace, name, content string) {
+		brickDir := dir.Join(namespace, name)
+		require.NoError(t, brickDir.MkdirAll())
+		require.NoError(t, brickDir.Join("brick.yaml").WriteFile([]byte(content)))
+	}
+	writeBrick("myteam", "sensor_hub", `name: Sensor Hub
+description: Collects the readings of the sensors
+require_container: true
+variables:
+- name: HUB_PORT
+  default_value: "8080"
+`)
+	require.NoError(t, dir.Join("myteam", "sensor_hub", "brick_compose.yaml").WriteFile([]byte("services: {}\n")))
+	writeBrick("myteam", "logger", "id: myteam:logger\nname: Logger\nauthor: John Doe\n")
+	writeBrick("myteam", "wrong_id", "id: myteam:other\n")
+	writeBrick("myteam", "no_compose", "require_container: true\n")
+	writeBrick("arduino", "web_ui", "name: Fake Web UI\n")
+
+	index := BricksIndex{Bricks: []Brick{{ID: "arduino:web_ui", Name: "Web UI"}}}
+	require.NoError(t, index.AddCustomBricks(dir))
+	require.Len(t, index.Bricks, 3)
+
+	builtin, found := index.FindBrickByID("arduino:web_ui")
+	require.True(t, found)
+	require.Equal(t, "Web UI", builtin.Name)
+	require.False(t, builtin.IsCustom())
+	require.Equal(t, "Arduino", builtin.GetAuthor())
+	require.Equal(t, BrickStatusInstalled, builtin.GetStatus())
+
+	hub, found := index.FindBrickByID("myteam:sensor_hub")
+	require.True(t, found)
+	require.Equal(t, "Sensor Hub", hub.Name)
+	require.True(t, hub.RequireContainer)
+	require.True(t, hub.IsCustom())
+	require.Equal(t, dir.Join("myteam", "sensor_hub").String(), hub.Dir.String())
+	require.Equal(t, "myteam", hub.GetAuthor())
+	require.Equal(t, BrickStatusCustom, hub.GetStatus())
+	require.Len(t, hub.Variables, 1)
+
+	logger, found := index.FindBrickByID("myteam:logger")
+	require.True(t, found)
+	require.Equal(t, "John Doe", logger.GetAuthor())
+
+	t.Run("missing directory", func(t *testing.T) {
+		index := BricksIndex{}
+		require.NoError(t, index.AddCustomBricks(dir.Join("not-existing")))
+		require.Empty(t, index.Bricks)
+	})
+}
+
+func TestMissingDependencies(t *testing.T) {
+	index := BricksIndex{Bricks: []Brick{
+		{ID: "arduino:video_objectdetection", DependsOn: []string{"arduino:usb_camera", "arduino:web_ui"}},
+		{ID: "arduino:usb_camera", DependsOn: []string{"arduino:camera_driver"}},
+		{ID: "arduino:camera_driver"},
+		{ID: "arduino:web_ui"},
+		{ID: "arduino:broken", DependsOn: []string{"arduino:not_existing"}},
+	}}
+
+	missing, err := index.MissingDependencies([]string{"arduino:video_objectdetection", "arduino:web_ui"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"arduino:usb_camera", "arduino:camera_driver"}, missing)
+
+	missing, err = index.MissingDependencies([]string{"arduino:web_ui"})
+	require.NoError(t, err)
+	require.Empty(t, missing)
+
+	_, err = index.MissingDependencies([]string{"arduino:broken"})
+	require.EqualError(t, err, `brick "arduino:broken" requires the brick "arduino:not_existing" that does not exist`)
+}
@@ -0,0 +1,403 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/containerd/errdefs"
+	"github.com/docker/cli/cli/command"
+	dockerClient "github.com/docker/docker/client"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+// A bundle is a gzipped tarball containing, in this order, the manifest, the assets
+// folder and the docker images saved with 'docker save'.
+const (
+	bundleManifestEntry = "bundle.json"
+	bundleAssetsEntry   = "assets"
+	bundleImagesEntry   = "images.tar"
+)
+
+type bundleManifest struct {
+	Version     string    `json:"version"`
+	RunnerImage string    `json:"runner_image"`
+	Images      []string  `json:"images"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type BundleCreateResult struct {
+	Path    string   `json:"path"`
+	Version string   `json:"version"`
+	Images  []string `json:"images"`
+	Size    int64    `json:"size"`
+}
+
+// BundleCreate saves the runner image, the images of the bricks and the assets in use into
+// a bundle, that can be loaded on a board without network access.
+func BundleCreate(ctx context.Context, cfg config.Configuration, staticStore *store.StaticStore, docker command.Cli, output *paths.Path) (BundleCreateResult, error) {
+	var result BundleCreateResult
+
+	assetsDir := cfg.AssetsDir().Join(cfg.UsedPythonImageTag)
+	if assetsDir.NotExist() {
+		return result, fmt.Errorf("the assets %s are not provisioned", cfg.UsedPythonImageTag)
+	}
+
+	brickImages, err := parseAllModelsRunnerImageTag(staticStore)
+	if err != nil {
+		return result, err
+	}
+	images := f.Uniq(append([]string{cfg.PythonImage}, resolveImages(cfg, brickImages)...))
+	for _, img := range images {
+		if _, err := docker.Client().ImageInspect(ctx, img); err != nil {
+			if errdefs.IsNotFound(err) {
+				return result, fmt.Errorf("image %s is not available locally, run 'arduino-app-cli system init' first", img)
+			}
+			return result, err
+		}
+	}
+
+	// The images are saved to a temporary file first, because the size of a tar entry
+	// must be known in advance.
+	imagesFile, err := paths.MkTempFile(output.Parent(), "bundle-images-")
+	if err != nil {
+		return result, err
+	}
+	defer func() { _ = paths.New(imagesFile.Name()).Remove() }()
+	defer imagesFile.Close()
+
+	feedback.Printf("Saving %d images ...", len(images))
+	saved, err := docker.Client().ImageSave(ctx, images)
+	if err != nil {
+		return result, fmt.Errorf("failed to save images: %w", err)
+	}
+	_, err = io.Copy(imagesFile, saved)
+	saved.Close()
+	if err != nil {
+		return result, fmt.Errorf("failed to save images: %w", err)
+	}
+	if _, err := imagesFile.Seek(0, io.SeekStart); err != nil {
+		return result, err
+	}
+
+	feedback.Printf("Writing bundle %s ...", output)
+	tmpOutput, err := paths.MkTempFile(output.Parent(), "bundle-")
+	if err != nil {
+		return result, err
+	}
+	defer func() { _ = paths.New(tmpOutput.Name()).Remove() }()
+	manifest := bundleManifest{
+		Version:     cfg.UsedPythonImageTag,
+		RunnerImage: cfg.PythonImage,
+		Images:      images,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := writeBundle(tmpOutput, manifest, assetsDir, imagesFile); err != nil {
+		tmpOutput.Close()
+		return result, err
+	}
+	if err := tmpOutput.Close(); err != nil {
+		return result, err
+	}
+	if err := paths.New(tmpOutput.Name()).Rename(output); err != nil {
+		return result, err
+	}
+
+	info, err := output.Stat()
+	if err != nil {
+		return result, err
+	}
+	return BundleCreateResult{
+		Path:    output.String(),
+		Version: manifest.Version,
+		Images:  images,
+		Size:    info.Size(),
+	}, nil
+}
+
+type BundleLoadResult struct {
+	Version     string   `json:"version"`
+	RunnerImage string   `json:"runner_image"`
+	Images      []string `json:"images"`
+}
+
+// BundleLoad imports the images and the assets of a bundle, so that they are found locally
+// by the provisioning and by 'system init'. The assets of the same version already
+// provisioned are replaced.
+func BundleLoad(ctx context.Context, cfg config.Configuration, docker command.Cli, input *paths.Path) (BundleLoadResult, error) {
+	var result BundleLoadResult
+
+	file, err := input.Open()
+	if err != nil {
+		return result, err
+	}
+	defer file.Close()
+
+	if err := cfg.AssetsDir().MkdirAll(); err != nil {
+		return result, err
+	}
+	// The prefix makes the leftovers of an interrupted load removed by 'system assets prune'.
+	tmpAssetsDir, err := cfg.AssetsDir().MkTempDir("dynamic-provisioning")
+	if err != nil {
+		return result, err
+	}
+	defer func() { _ = tmpAssetsDir.RemoveAll() }()
+
+	manifest, err := readBundle(file, tmpAssetsDir, func(manifest bundleManifest, images io.Reader) error {
+		feedback.Printf("Loading %d images ...", len(manifest.Images))
+		return loadImages(ctx, docker.Client(), images)
+	})
+	if err != nil {
+		return result, err
+	}
+
+	assetsDir := cfg.AssetsDir().Join(manifest.Version)
+	if err := assetsDir.RemoveAll(); err != nil {
+		return result, err
+	}
+	if err := tmpAssetsDir.Rename(assetsDir); err != nil {
+		return result, fmt.Errorf("failed to install the assets: %w", err)
+	}
+	return BundleLoadResult{
+		Version:     manifest.Version,
+		RunnerImage: manifest.RunnerImage,
+		Images:      manifest.Images,
+	}, nil
+}
+
+func loadImages(ctx context.Context, docker dockerClient.APIClient, images io.Reader) error {
+	resp, err := docker.ImageLoad(ctx, images, dockerClient.ImageLoadWithQuiet(true))
+	if err != nil {
+		return fmt.Errorf("failed to load images: %w", err)
+	}
+	defer resp.Body.Close()
+	if !resp.JSON {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var msg struct {
+			Error string `json:"error"`
+		}
+		if err := decoder.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if msg.Error != "" {
+			return fmt.Errorf("failed to load images: %s", msg.Error)
+		}
+	}
+}
+
+func writeBundle(w io.Writer, manifest bundleManifest, assetsDir *paths.Path, images io.Reader) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: bundleManifestEntry, Mode: 0644, Size: int64(len(content)), ModTime: manifest.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return err
+	}
+
+	if err := addAssets(tw, assetsDir); err != nil {
+		return fmt.Errorf("failed to add the assets: %w", err)
+	}
+
+	// The images are the last entry, so that they can be streamed to docker while reading.
+	size, err := readerSize(images)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: bundleImagesEntry, Mode: 0644, Size: size, ModTime: manifest.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, images); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func readerSize(r io.Reader) (int64, error) {
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		return 0, errors.New("the size of the images is unknown")
+	}
+	size, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	_, err = seeker.Seek(0, io.SeekStart)
+	return size, err
+}
+
+// readBundle extracts the assets of the bundle into assetsDir and passes the images to
+// loadImages.
+func readBundle(r io.Reader, assetsDir *paths.Path, loadImages func(bundleManifest, io.Reader) error) (bundleManifest, error) {
+	var manifest bundleManifest
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return manifest, fmt.Errorf("invalid bundle: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return manifest, fmt.Errorf("invalid bundle: %w", err)
+	}
+	if hdr.Name != bundleManifestEntry {
+		return manifest, fmt.Errorf("invalid bundle: missing %s", bundleManifestEntry)
+	}
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return manifest, fmt.Errorf("invalid bundle manifest: %w", err)
+	}
+	if !assets.ValidVersion(manifest.Version) {
+		return manifest, fmt.Errorf("invalid bundle manifest: %w: %q", assets.ErrInvalidVersion, manifest.Version)
+	}
+
+	imagesLoaded := false
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return manifest, fmt.Errorf("invalid bundle: %w", err)
+		}
+
+		if hdr.Name == bundleImagesEntry {
+			if err := loadImages(manifest, tr); err != nil {
+				return manifest, err
+			}
+			imagesLoaded = true
+			continue
+		}
+
+		if hdr.Name == bundleAssetsEntry+"/" {
+			continue
+		}
+		name, found := strings.CutPrefix(hdr.Name, bundleAssetsEntry+"/")
+		if !found || !filepath.IsLocal(name) {
+			return manifest, fmt.Errorf("invalid bundle: unexpected entry %s", hdr.Name)
+		}
+		target := assetsDir.Join(filepath.FromSlash(name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := target.MkdirAll(); err != nil {
+				return manifest, err
+			}
+		case tar.TypeReg:
+			if err := target.Parent().MkdirAll(); err != nil {
+				return manifest, err
+			}
+			if err := extractFile(tr, target, hdr.FileInfo().Mode().Perm()); err != nil {
+				return manifest, err
+			}
+		default:
+			return manifest, fmt.Errorf("invalid bundle: unsupported entry %s", hdr.Name)
+		}
+	}
+	if !imagesLoaded {
+		return manifest, fmt.Errorf("invalid bundle: missing %s", bundleImagesEntry)
+	}
+	return manifest, nil
+}
+
+func extractFile(r io.Reader, target *paths.Path, perm fs.FileMode) error {
+	file, err := target.Create()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return target.Chmod(perm)
+}
+
+func addAssets(tw *tar.Writer, assetsDir *paths.Path) error {
+	return filepath.WalkDir(assetsDir.String(), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(assetsDir.String(), p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return fmt.Errorf("unsupported file %s", p)
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(bundleAssetsEntry, filepath.ToSlash(rel))
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		file, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+}
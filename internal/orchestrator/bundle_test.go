@@ -0,0 +1,96 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle(t *testing.T) {
+	assetsDir := paths.New(t.TempDir())
+	require.NoError(t, assetsDir.Join("compose", "arduino", "dbstorage_influx").MkdirAll())
+	require.NoError(t, assetsDir.Join("bricks-list.yaml").WriteFile([]byte("bricks: []\n")))
+	require.NoError(t, assetsDir.Join("compose", "arduino", "dbstorage_influx", "brick_compose.yaml").WriteFile([]byte("services: {}\n")))
+
+	manifest := bundleManifest{
+		Version:     "0.5.0",
+		RunnerImage: "ghcr.io/arduino/app-bricks/python-apps-base:0.5.0",
+		Images:      []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.5.0", "influxdb:2.7"},
+		CreatedAt:   time.Now().UTC(),
+	}
+	var bundle bytes.Buffer
+	require.NoError(t, writeBundle(&bundle, manifest, assetsDir, bytes.NewReader([]byte("images"))))
+
+	t.Run("load", func(t *testing.T) {
+		target := paths.New(t.TempDir())
+		var images []byte
+		res, err := readBundle(bytes.NewReader(bundle.Bytes()), target, func(m bundleManifest, r io.Reader) error {
+			require.Equal(t, manifest.Images, m.Images)
+			var err error
+			images, err = io.ReadAll(r)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, manifest.Version, res.Version)
+		require.Equal(t, manifest.RunnerImage, res.RunnerImage)
+		require.Equal(t, "images", string(images))
+
+		content, err := target.Join("bricks-list.yaml").ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, "bricks: []\n", string(content))
+		require.True(t, target.Join("compose", "arduino", "dbstorage_influx", "brick_compose.yaml").Exist())
+	})
+
+	t.Run("invalid entries", func(t *testing.T) {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		addEntry := func(name string, content []byte) {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+			_, err := tw.Write(content)
+			require.NoError(t, err)
+		}
+		content, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		addEntry(bundleManifestEntry, content)
+		addEntry("assets/../../evil", []byte("evil"))
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+
+		target := paths.New(t.TempDir(), "assets")
+		_, err = readBundle(&buf, target, func(bundleManifest, io.Reader) error { return nil })
+		require.ErrorContains(t, err, "unexpected entry")
+		require.False(t, target.Parent().Parent().Join("evil").Exist())
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		invalid := manifest
+		invalid.Version = "../0.5.0"
+		var buf bytes.Buffer
+		require.NoError(t, writeBundle(&buf, invalid, assetsDir, bytes.NewReader(nil)))
+		_, err := readBundle(&buf, paths.New(t.TempDir()), func(bundleManifest, io.Reader) error { return nil })
+		require.ErrorContains(t, err, "invalid assets version")
+	})
+}
@@ -35,11 +35,16 @@ type Configuration struct {
 	dataDir            *paths.Path
 	routerSocketPath   *paths.Path
 	customEIModelsDir  *paths.Path
+	customBricksDir    *paths.Path
 	PythonImage        string
 	UsedPythonImageTag string
 	RunnerVersion      string
-	AllowRoot          bool
-	LibrariesAPIURL    *url.URL
+	// PinnedAssetsVersion is the version of the assets pinned by the user, if any.
+	PinnedAssetsVersion string
+	AllowRoot           bool
+	LibrariesAPIURL     *url.URL
+	// Settings are read from the configuration files and from the environment.
+	Settings
 }
 
 func NewFromEnv() (Configuration, error) {
@@ -89,7 +94,28 @@ func NewFromEnv() (Configuration, error) {
 		}
 	}
 
+	customBricksDir := paths.New(os.Getenv("ARDUINO_APP_CLI__BRICKS_DIR"))
+	if customBricksDir == nil {
+		customBricksDir = dataDir.Join("bricks")
+	}
+
+	settings, err := loadSettings(systemConfigFile(), configFile(dataDir))
+	if err != nil {
+		return Configuration{}, err
+	}
+	settings.applyEnv()
+	if err := settings.validate(); err != nil {
+		return Configuration{}, err
+	}
+
 	pythonImage, usedPythonImageTag := getPythonImageAndTag()
+	// A pinned version of the assets selects the runner image with the same tag.
+	pinnedAssetsVersion := readPinnedAssetsVersion(dataDir)
+	if pinnedAssetsVersion != "" {
+		pythonImage = strings.TrimSuffix(pythonImage, ":"+usedPythonImageTag) + ":" + pinnedAssetsVersion
+		usedPythonImageTag = pinnedAssetsVersion
+	}
+	pythonImage = settings.Images.Resolve(pythonImage)
 	slog.Debug("Using pythonImage", slog.String("image", pythonImage))
 
 	allowRoot, err := strconv.ParseBool(os.Getenv("ARDUINO_APP_CLI__ALLOW_ROOT"))
@@ -107,15 +133,18 @@ func NewFromEnv() (Configuration, error) {
 	}
 
 	c := Configuration{
-		appsDir:            appsDir,
-		dataDir:            dataDir,
-		routerSocketPath:   routerSocket,
-		customEIModelsDir:  customEIModelsDir,
-		PythonImage:        pythonImage,
-		UsedPythonImageTag: usedPythonImageTag,
-		RunnerVersion:      runnerVersion,
-		AllowRoot:          allowRoot,
-		LibrariesAPIURL:    parsedLibrariesURL,
+		appsDir:             appsDir,
+		dataDir:             dataDir,
+		routerSocketPath:    routerSocket,
+		customEIModelsDir:   customEIModelsDir,
+		customBricksDir:     customBricksDir,
+		PythonImage:         pythonImage,
+		UsedPythonImageTag:  usedPythonImageTag,
+		RunnerVersion:       runnerVersion,
+		PinnedAssetsVersion: pinnedAssetsVersion,
+		AllowRoot:           allowRoot,
+		LibrariesAPIURL:     parsedLibrariesURL,
+		Settings:            settings,
 	}
 	if err := c.init(); err != nil {
 		return Configuration{}, err
@@ -152,10 +181,72 @@ func (c *Configuration) RouterSocketPath() *paths.Path {
 	return c.routerSocketPath
 }
 
+// CustomEIModelsDir is the folder containing the custom Edge Impulse models.
+func (c *Configuration) CustomEIModelsDir() *paths.Path {
+	return c.customEIModelsDir
+}
+
+// CustomBricksDir is the folder containing the bricks authored by the user.
+func (c *Configuration) CustomBricksDir() *paths.Path {
+	return c.customBricksDir
+}
+
+// ConfigFile is the configuration file of the user, it can be moved with ARDUINO_APP_CLI__CONFIG_FILE.
+func (c *Configuration) ConfigFile() *paths.Path {
+	return configFile(c.dataDir)
+}
+
+// SystemConfigFile is the system-wide configuration file, overridden by the one of the user.
+func (c *Configuration) SystemConfigFile() *paths.Path {
+	return systemConfigFile()
+}
+
 func (c *Configuration) AssetsDir() *paths.Path {
 	return c.dataDir.Join("assets")
 }
 
+// TokensFile contains the hashes of the tokens issued to call the HTTP API.
+func (c *Configuration) TokensFile() *paths.Path {
+	return c.dataDir.Join("auth", "tokens.json")
+}
+
+// DesktopTokenFile contains the token identifying the desktop client.
+func (c *Configuration) DesktopTokenFile() *paths.Path {
+	return c.dataDir.Join("auth", "desktop-token")
+}
+
+// AuditFile is the log of the operations performed through the API and the CLI.
+func (c *Configuration) AuditFile() *paths.Path {
+	return c.dataDir.Join("audit", "audit.log")
+}
+
+// TLSDir contains the self-signed certificate of the remote listener.
+func (c *Configuration) TLSDir() *paths.Path {
+	return c.dataDir.Join("tls")
+}
+
+// PinnedAssetsFile contains the version of the assets pinned by the user.
+func (c *Configuration) PinnedAssetsFile() *paths.Path {
+	return pinnedAssetsFile(c.dataDir)
+}
+
+// LoadedAssetsFile contains the version of the assets loaded by the daemon.
+func (c *Configuration) LoadedAssetsFile() *paths.Path {
+	return c.dataDir.Join("assets", "loaded-version")
+}
+
+func pinnedAssetsFile(dataDir *paths.Path) *paths.Path {
+	return dataDir.Join("assets", "pinned-version")
+}
+
+func readPinnedAssetsVersion(dataDir *paths.Path) string {
+	content, err := pinnedAssetsFile(dataDir).ReadFile()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
 func getPythonImageAndTag() (string, string) {
 	registryBase := os.Getenv("DOCKER_REGISTRY_BASE")
 	if registryBase == "" {
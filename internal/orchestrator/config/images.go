@@ -0,0 +1,153 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/distribution/reference"
+)
+
+var digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ImagesConfig maps the upstream images, referenced by the runner and by the bricks, to the
+// images actually pulled and run.
+type ImagesConfig struct {
+	// Mirrors maps a registry, or a repository prefix, to the one of a mirror.
+	// For example "ghcr.io/arduino" to "registry.example.com/arduino".
+	Mirrors map[string]string `yaml:"mirrors,omitempty" json:"mirrors,omitempty"`
+	// Digests pins an upstream image, for example "influxdb:2.7", to a digest.
+	Digests map[string]string `yaml:"digests,omitempty" json:"digests,omitempty"`
+}
+
+func (c ImagesConfig) validate() error {
+	for from, to := range c.Mirrors {
+		if strings.TrimSuffix(from, "/") == "" || strings.TrimSuffix(to, "/") == "" {
+			return fmt.Errorf("invalid mirror %q: %q", from, to)
+		}
+	}
+	for image, digest := range c.Digests {
+		if _, err := reference.ParseNormalizedNamed(image); err != nil {
+			return fmt.Errorf("invalid image %q: %w", image, err)
+		}
+		if !digestRegexp.MatchString(digest) {
+			return fmt.Errorf("invalid digest of the image %q: %q", image, digest)
+		}
+	}
+	return nil
+}
+
+// MirrorPrefixes returns the repository prefixes of the mirrors.
+func (c ImagesConfig) MirrorPrefixes() []string {
+	res := make([]string, 0, len(c.Mirrors))
+	for _, to := range c.Mirrors {
+		res = append(res, strings.TrimSuffix(to, "/")+"/")
+	}
+	return res
+}
+
+// Resolve returns the image to use in place of the upstream one. A pinned image is returned
+// as a reference by digest, without the tag.
+func (c ImagesConfig) Resolve(image string) string {
+	if len(c.Mirrors) == 0 && len(c.Digests) == 0 {
+		return image
+	}
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return image
+	}
+	named = reference.TagNameOnly(named)
+
+	digest := c.digest(named)
+	if _, ok := named.(reference.Digested); ok {
+		// Already pinned upstream.
+		digest = ""
+	}
+
+	name := named.Name()
+	mirrored := false
+	if from, to, ok := c.mirror(name); ok {
+		name = strings.TrimSuffix(to, "/") + strings.TrimPrefix(name, strings.TrimSuffix(from, "/"))
+		mirrored = true
+	} else if from, to, ok := c.mirror(reference.FamiliarName(named)); ok {
+		name = strings.TrimSuffix(to, "/") + strings.TrimPrefix(reference.FamiliarName(named), strings.TrimSuffix(from, "/"))
+		mirrored = true
+	}
+	if !mirrored && digest == "" {
+		return image
+	}
+	if !mirrored {
+		// Docker lists the images of Docker Hub by their familiar name.
+		name = reference.FamiliarName(named)
+	}
+
+	switch {
+	case digest != "":
+		return name + "@" + digest
+	default:
+		// named is either tagged or digested, TagNameOnly adds the latest tag.
+		return name + strings.TrimPrefix(named.String(), named.Name())
+	}
+}
+
+// SameImage reports if the two references point to the same image, once normalized. For
+// example "influxdb@sha256:..." is the same image of "docker.io/library/influxdb@sha256:...".
+func SameImage(a, b string) bool {
+	if a == b {
+		return true
+	}
+	na, err := reference.ParseNormalizedNamed(a)
+	if err != nil {
+		return false
+	}
+	nb, err := reference.ParseNormalizedNamed(b)
+	if err != nil {
+		return false
+	}
+	return reference.TagNameOnly(na).String() == reference.TagNameOnly(nb).String()
+}
+
+func (c ImagesConfig) digest(named reference.Named) string {
+	for _, key := range []string{named.String(), reference.FamiliarString(named)} {
+		if digest, ok := c.Digests[key]; ok {
+			return digest
+		}
+	}
+	// The keys of the digests are normalized too, "library/influxdb:2.7" matches "influxdb:2.7".
+	for image, digest := range c.Digests {
+		if n, err := reference.ParseNormalizedNamed(image); err == nil && reference.TagNameOnly(n).String() == named.String() {
+			return digest
+		}
+	}
+	return ""
+}
+
+// mirror returns the longest prefix of the mirrors matching the name, on a path boundary.
+func (c ImagesConfig) mirror(name string) (string, string, bool) {
+	var from, to string
+	for f, t := range c.Mirrors {
+		prefix := strings.TrimSuffix(f, "/")
+		if name != prefix && !strings.HasPrefix(name, prefix+"/") {
+			continue
+		}
+		if len(prefix) > len(strings.TrimSuffix(from, "/")) {
+			from, to = f, t
+		}
+	}
+	return from, to, from != ""
+}
@@ -0,0 +1,74 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImagesResolve(t *testing.T) {
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	images := ImagesConfig{
+		Mirrors: map[string]string{
+			"ghcr.io/arduino":            "registry.example.com/arduino",
+			"ghcr.io/arduino/app-bricks": "registry.example.com/bricks/",
+			"docker.io/library":          "registry.example.com/library",
+		},
+		Digests: map[string]string{
+			"influxdb:2.7": digest,
+		},
+	}
+
+	testCases := []struct {
+		image    string
+		expected string
+	}{
+		{"ghcr.io/arduino/app-bricks/python-apps-base:0.5.0", "registry.example.com/bricks/python-apps-base:0.5.0"},
+		{"ghcr.io/arduino/other:1.0", "registry.example.com/arduino/other:1.0"},
+		{"ghcr.io/arduinos/other:1.0", "ghcr.io/arduinos/other:1.0"},
+		{"influxdb:2.7", "registry.example.com/library/influxdb@" + digest},
+		{"docker.io/library/influxdb:2.7", "registry.example.com/library/influxdb@" + digest},
+		{"influxdb:2.8", "registry.example.com/library/influxdb:2.8"},
+		{"influxdb", "registry.example.com/library/influxdb:latest"},
+		{"public.ecr.aws/arduino/model:1.0", "public.ecr.aws/arduino/model:1.0"},
+		{"not a valid image", "not a valid image"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.image, func(t *testing.T) {
+			require.Equal(t, tc.expected, images.Resolve(tc.image))
+		})
+	}
+
+	require.Equal(t, "influxdb:2.7", ImagesConfig{}.Resolve("influxdb:2.7"))
+	require.Equal(t, "ghcr.io/arduino/x@"+digest, ImagesConfig{Digests: map[string]string{"ghcr.io/arduino/x:1.0": digest}}.Resolve("ghcr.io/arduino/x:1.0"))
+
+	// Without a mirror the images of Docker Hub are pinned with the name listed by Docker.
+	pinned := ImagesConfig{Digests: map[string]string{"influxdb:2.7": digest}}
+	require.Equal(t, "influxdb@"+digest, pinned.Resolve("influxdb:2.7"))
+	require.Equal(t, "influxdb@"+digest, pinned.Resolve("docker.io/library/influxdb:2.7"))
+	require.True(t, SameImage("docker.io/library/influxdb@"+digest, "influxdb@"+digest))
+}
+
+func TestSameImage(t *testing.T) {
+	require.True(t, SameImage("influxdb:2.7", "docker.io/library/influxdb:2.7"))
+	require.True(t, SameImage("influxdb", "influxdb:latest"))
+	require.True(t, SameImage("ghcr.io/arduino/x:1.0", "ghcr.io/arduino/x:1.0"))
+	require.False(t, SameImage("influxdb:2.7", "influxdb:2.8"))
+	require.False(t, SameImage("ghcr.io/arduino/x:1.0", "registry.example.com/arduino/x:1.0"))
+	require.False(t, SameImage("not a valid image", "influxdb"))
+}
@@ -0,0 +1,384 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	yaml "github.com/goccy/go-yaml"
+)
+
+// Settings are the options that can be set in the configuration files. The system-wide file
+// is overridden by the one of the user, that is overridden by the environment variables.
+type Settings struct {
+	// AllowMultipleApps allows apps without a sketch to run side by side.
+	AllowMultipleApps bool              `yaml:"allow_multiple_apps" json:"allow_multiple_apps"`
+	Daemon            DaemonSettings    `yaml:"daemon" json:"daemon"`
+	Auth              AuthSettings      `yaml:"auth" json:"auth"`
+	Logs              LogsSettings      `yaml:"logs" json:"logs"`
+	Resources         ResourcesSettings `yaml:"resources" json:"resources"`
+	Audit             AuditSettings     `yaml:"audit" json:"audit"`
+	// Images maps the upstream images to the mirrored and pinned ones.
+	Images ImagesConfig `yaml:"images" json:"images"`
+}
+
+type DaemonSettings struct {
+	Port        string   `yaml:"port" json:"port"`
+	CORSOrigins []string `yaml:"cors_origins" json:"cors_origins"`
+	// Remote exposes the API on the local network, over TLS.
+	Remote RemoteSettings `yaml:"remote" json:"remote"`
+}
+
+// RemoteSettings configures the TLS listener of the daemon on the local network. A token
+// is always required on this listener.
+type RemoteSettings struct {
+	Enabled bool   `yaml:"enabled" json:"enabled"`
+	Port    string `yaml:"port" json:"port"`
+	// CertFile and KeyFile are the certificate provided by the user, a self-signed one
+	// is generated on the first start when empty.
+	CertFile string `yaml:"cert_file" json:"cert_file"`
+	KeyFile  string `yaml:"key_file" json:"key_file"`
+	// Advertise publishes the listener with avahi, as _arduino-app-cli._tcp.
+	Advertise bool `yaml:"advertise" json:"advertise"`
+}
+
+// AuthSettings configures the authentication of the HTTP API.
+type AuthSettings struct {
+	// Enabled requires a token, issued with the CLI, to call the API.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// DesktopClientExempt lets the desktop client, identified by the desktop token issued at
+	// install time, call the API without a token granting the scopes.
+	DesktopClientExempt bool `yaml:"desktop_client_exempt" json:"desktop_client_exempt"`
+}
+
+// LogsSettings configures the rotation of the logs of the app containers.
+type LogsSettings struct {
+	MaxSize  string `yaml:"max_size" json:"max_size"`
+	MaxFiles int    `yaml:"max_files" json:"max_files"`
+}
+
+// ResourcesSettings configures how often the system resources are sampled.
+type ResourcesSettings struct {
+	CPUScrapeInterval    Duration `yaml:"cpu_scrape_interval" json:"cpu_scrape_interval"`
+	MemoryScrapeInterval Duration `yaml:"memory_scrape_interval" json:"memory_scrape_interval"`
+	DiskScrapeInterval   Duration `yaml:"disk_scrape_interval" json:"disk_scrape_interval"`
+}
+
+// AuditSettings configures the rotation of the audit log.
+type AuditSettings struct {
+	MaxSize  string `yaml:"max_size" json:"max_size"`
+	MaxFiles int    `yaml:"max_files" json:"max_files"`
+}
+
+// MaxSizeBytes returns the size, in bytes, over which the audit log is rotated.
+func (a AuditSettings) MaxSizeBytes() int64 {
+	size, unit := a.MaxSize, int64(1)
+	switch {
+	case strings.HasSuffix(size, "k"):
+		unit = 1024
+	case strings.HasSuffix(size, "m"):
+		unit = 1024 * 1024
+	case strings.HasSuffix(size, "g"):
+		unit = 1024 * 1024 * 1024
+	}
+	n, _ := strconv.ParseInt(strings.TrimRight(size, "kmg"), 10, 64)
+	return n * unit
+}
+
+// Duration is a time.Duration written as a string, like "5s", in the configuration.
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func defaultSettings() Settings {
+	return Settings{
+		Daemon: DaemonSettings{
+			Port: "8080",
+			CORSOrigins: []string{
+				"wails://wails",
+				"wails://wails.localhost:*",
+				"http://wails.localhost:*",
+				"http://localhost:*",
+				"https://localhost:*",
+			},
+			Remote: RemoteSettings{
+				Port:      "8443",
+				Advertise: true,
+			},
+		},
+		Logs: LogsSettings{
+			MaxSize:  "5m",
+			MaxFiles: 2,
+		},
+		Resources: ResourcesSettings{
+			CPUScrapeInterval:    Duration(5 * time.Second),
+			MemoryScrapeInterval: Duration(5 * time.Second),
+			DiskScrapeInterval:   Duration(30 * time.Second),
+		},
+		Audit: AuditSettings{
+			MaxSize:  "10m",
+			MaxFiles: 5,
+		},
+	}
+}
+
+var logSizeRegexp = regexp.MustCompile(`^[1-9][0-9]*[kmg]?$`)
+
+func (s Settings) validate() error {
+	if port, err := strconv.Atoi(s.Daemon.Port); err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("daemon.port: invalid port %q", s.Daemon.Port)
+	}
+	if port, err := strconv.Atoi(s.Daemon.Remote.Port); err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("daemon.remote.port: invalid port %q", s.Daemon.Remote.Port)
+	}
+	if s.Daemon.Remote.Enabled && s.Daemon.Remote.Port == s.Daemon.Port {
+		return fmt.Errorf("daemon.remote.port: must be different from daemon.port")
+	}
+	if (s.Daemon.Remote.CertFile == "") != (s.Daemon.Remote.KeyFile == "") {
+		return fmt.Errorf("daemon.remote: cert_file and key_file must be set together")
+	}
+	if !logSizeRegexp.MatchString(s.Logs.MaxSize) {
+		return fmt.Errorf("logs.max_size: invalid size %q, use a number followed by k, m or g", s.Logs.MaxSize)
+	}
+	if s.Logs.MaxFiles < 1 {
+		return fmt.Errorf("logs.max_files: must be at least 1")
+	}
+	if !logSizeRegexp.MatchString(s.Audit.MaxSize) {
+		return fmt.Errorf("audit.max_size: invalid size %q, use a number followed by k, m or g", s.Audit.MaxSize)
+	}
+	if s.Audit.MaxFiles < 1 {
+		return fmt.Errorf("audit.max_files: must be at least 1")
+	}
+	for key, interval := range map[string]Duration{
+		"resources.cpu_scrape_interval":    s.Resources.CPUScrapeInterval,
+		"resources.memory_scrape_interval": s.Resources.MemoryScrapeInterval,
+		"resources.disk_scrape_interval":   s.Resources.DiskScrapeInterval,
+	} {
+		if time.Duration(interval) < time.Second {
+			return fmt.Errorf("%s: must be at least 1s", key)
+		}
+	}
+	if err := s.Images.validate(); err != nil {
+		return fmt.Errorf("images: %w", err)
+	}
+	return nil
+}
+
+// systemConfigFile is the system-wide configuration file, it can be moved with
+// ARDUINO_APP_CLI__SYSTEM_CONFIG_FILE.
+func systemConfigFile() *paths.Path {
+	if file := paths.New(os.Getenv("ARDUINO_APP_CLI__SYSTEM_CONFIG_FILE")); file != nil {
+		return file
+	}
+	return paths.New("/etc/arduino-app-cli/config.yaml")
+}
+
+func configFile(dataDir *paths.Path) *paths.Path {
+	if file := paths.New(os.Getenv("ARDUINO_APP_CLI__CONFIG_FILE")); file != nil {
+		return file
+	}
+	return dataDir.Join("config.yaml")
+}
+
+// loadSettings applies the configuration files, in order, over the defaults. The settings
+// are validated later, after the environment variables are applied.
+func loadSettings(files ...*paths.Path) (Settings, error) {
+	settings := defaultSettings()
+	for _, file := range files {
+		if err := readConfigFile(file, &settings); err != nil {
+			return settings, err
+		}
+	}
+	return settings, nil
+}
+
+// readConfigFile overrides the settings with the ones of the file, a missing file is ignored.
+func readConfigFile(file *paths.Path, settings *Settings) error {
+	content, err := file.ReadFile()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	if err := yaml.UnmarshalWithOptions(content, settings, yaml.Strict()); err != nil {
+		return fmt.Errorf("invalid configuration file %s: %w", file, err)
+	}
+	return nil
+}
+
+// applyEnv overrides the settings with the environment variables.
+func (s *Settings) applyEnv() {
+	if v, err := strconv.ParseBool(os.Getenv("ARDUINO_APP_CLI__ALLOW_MULTIPLE_APPS")); err == nil {
+		s.AllowMultipleApps = v
+	}
+	if v := os.Getenv("ARDUINO_APP_CLI__DAEMON_PORT"); v != "" {
+		s.Daemon.Port = v
+	}
+	if v := os.Getenv("ARDUINO_APP_CLI__CORS_ORIGINS"); v != "" {
+		s.Daemon.CORSOrigins = strings.Split(v, ",")
+	}
+	if v, err := strconv.ParseBool(os.Getenv("ARDUINO_APP_CLI__AUTH_ENABLED")); err == nil {
+		s.Auth.Enabled = v
+	}
+	if v, err := strconv.ParseBool(os.Getenv("ARDUINO_APP_CLI__REMOTE_ENABLED")); err == nil {
+		s.Daemon.Remote.Enabled = v
+	}
+}
+
+// SettingsKeys returns the keys of the settings, like "daemon.port".
+func SettingsKeys() []string {
+	var keys []string
+	walkSettings(reflect.ValueOf(Settings{}), "", func(key string, _ reflect.Value) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// List returns the value of every setting, formatted as in the configuration file.
+func (s Settings) List() map[string]string {
+	res := map[string]string{}
+	walkSettings(reflect.ValueOf(s), "", func(key string, v reflect.Value) {
+		res[key] = formatSetting(v)
+	})
+	return res
+}
+
+// Get returns the value of a setting, formatted as in the configuration file.
+func (s Settings) Get(key string) (string, error) {
+	value, ok := s.List()[key]
+	if !ok {
+		return "", fmt.Errorf("unknown setting %q", key)
+	}
+	return value, nil
+}
+
+// SetUserSetting writes a setting into the configuration file of the user. The value is
+// parsed as YAML, for example "8081" or "[http://localhost:*]". The change is applied from
+// the next start.
+func SetUserSetting(c Configuration, key, value string) error {
+	if !slices.Contains(SettingsKeys(), key) {
+		return fmt.Errorf("unknown setting %q", key)
+	}
+	var parsed any
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return fmt.Errorf("invalid value %q: %w", value, err)
+	}
+
+	file := c.ConfigFile()
+	content := map[string]any{}
+	if data, err := file.ReadFile(); err == nil {
+		if err := yaml.Unmarshal(data, &content); err != nil {
+			return fmt.Errorf("invalid configuration file %s: %w", file, err)
+		}
+		if content == nil {
+			content = map[string]any{}
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	section := content
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := section[part].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			section[part] = next
+		}
+		section = next
+	}
+	section[parts[len(parts)-1]] = parsed
+
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return err
+	}
+	// Check the resulting settings before writing them.
+	settings := defaultSettings()
+	if err := yaml.UnmarshalWithOptions(data, &settings, yaml.Strict()); err != nil {
+		return fmt.Errorf("invalid value %q for %s: %w", value, key, err)
+	}
+	if err := settings.validate(); err != nil {
+		return err
+	}
+	if err := file.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return file.WriteFile(data)
+}
+
+// walkSettings calls fn on the leaves of the settings, the maps are leaves too.
+func walkSettings(v reflect.Value, prefix string, fn func(key string, v reflect.Value)) {
+	t := v.Type()
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		key := prefix + name
+		if t.Field(i).Type.Kind() == reflect.Struct {
+			walkSettings(v.Field(i), key+".", fn)
+			continue
+		}
+		fn(key, v.Field(i))
+	}
+}
+
+func formatSetting(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Map:
+		if v.Len() == 0 {
+			return "{}"
+		}
+	case reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range v.Len() {
+			items[i] = formatSetting(v.Index(i))
+		}
+		return strings.Join(items, ",")
+	}
+	if m, ok := v.Interface().(interface{ MarshalText() ([]byte, error) }); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(data)
+}
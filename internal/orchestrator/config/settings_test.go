@@ -0,0 +1,134 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	systemFile := tmp.Join("system.yaml")
+	userFile := tmp.Join("user.yaml")
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	t.Setenv("ARDUINO_APP_BRICKS__CUSTOM_MODEL_DIR", tmp.Join("models").String())
+	t.Setenv("ARDUINO_APP_CLI__SYSTEM_CONFIG_FILE", systemFile.String())
+	t.Setenv("ARDUINO_APP_CLI__CONFIG_FILE", userFile.String())
+
+	t.Run("defaults", func(t *testing.T) {
+		cfg, err := NewFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, defaultSettings(), cfg.Settings)
+	})
+
+	require.NoError(t, systemFile.WriteFile([]byte(`
+daemon:
+  port: "9000"
+  cors_origins: ["https://fleet.example.com"]
+logs:
+  max_size: 10m
+resources:
+  disk_scrape_interval: 1m
+`)))
+	require.NoError(t, userFile.WriteFile([]byte(`
+daemon:
+  port: "9001"
+logs:
+  max_files: 5
+`)))
+
+	t.Run("layers", func(t *testing.T) {
+		cfg, err := NewFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, "9001", cfg.Daemon.Port)
+		require.Equal(t, []string{"https://fleet.example.com"}, cfg.Daemon.CORSOrigins)
+		require.Equal(t, LogsSettings{MaxSize: "10m", MaxFiles: 5}, cfg.Logs)
+		require.Equal(t, Duration(time.Minute), cfg.Resources.DiskScrapeInterval)
+		require.Equal(t, Duration(5*time.Second), cfg.Resources.CPUScrapeInterval)
+
+		t.Setenv("ARDUINO_APP_CLI__DAEMON_PORT", "9002")
+		t.Setenv("ARDUINO_APP_CLI__ALLOW_MULTIPLE_APPS", "true")
+		cfg, err = NewFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, "9002", cfg.Daemon.Port)
+		require.True(t, cfg.AllowMultipleApps)
+	})
+
+	t.Run("get and list", func(t *testing.T) {
+		cfg, err := NewFromEnv()
+		require.NoError(t, err)
+		value, err := cfg.Get("resources.disk_scrape_interval")
+		require.NoError(t, err)
+		require.Equal(t, "1m0s", value)
+		value, err = cfg.Get("daemon.cors_origins")
+		require.NoError(t, err)
+		require.Equal(t, "https://fleet.example.com", value)
+		_, err = cfg.Get("daemon.unknown")
+		require.Error(t, err)
+
+		list := cfg.List()
+		require.Equal(t, "false", list["allow_multiple_apps"])
+		require.Equal(t, "{}", list["images.mirrors"])
+		require.ElementsMatch(t, SettingsKeys(), func() []string {
+			keys := []string{}
+			for k := range list {
+				keys = append(keys, k)
+			}
+			return keys
+		}())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		cfg, err := NewFromEnv()
+		require.NoError(t, err)
+		require.NoError(t, SetUserSetting(cfg, "resources.cpu_scrape_interval", "2s"))
+		require.NoError(t, SetUserSetting(cfg, "daemon.cors_origins", "[http://localhost:*]"))
+		require.ErrorContains(t, SetUserSetting(cfg, "daemon.port", "99999"), "invalid port")
+		require.ErrorContains(t, SetUserSetting(cfg, "daemon.host", "x"), "unknown setting")
+		require.Error(t, SetUserSetting(cfg, "logs.max_files", "many"))
+		require.ErrorContains(t, SetUserSetting(cfg, "daemon.remote.cert_file", "/etc/ssl/board.pem"), "set together")
+		require.NoError(t, SetUserSetting(cfg, "daemon.remote.enabled", "true"))
+
+		cfg, err = NewFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, Duration(2*time.Second), cfg.Resources.CPUScrapeInterval)
+		require.Equal(t, []string{"http://localhost:*"}, cfg.Daemon.CORSOrigins)
+		require.Equal(t, "9001", cfg.Daemon.Port)
+		require.Equal(t, 5, cfg.Logs.MaxFiles)
+		require.True(t, cfg.Daemon.Remote.Enabled)
+		require.Equal(t, "8443", cfg.Daemon.Remote.Port)
+		require.Equal(t, int64(10*1024*1024), cfg.Audit.MaxSizeBytes())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require.NoError(t, userFile.WriteFile([]byte("logs:\n  max_size: huge\n")))
+		_, err := NewFromEnv()
+		require.ErrorContains(t, err, "logs.max_size")
+
+		require.NoError(t, userFile.WriteFile([]byte("images:\n  digests:\n    influxdb:2.7: latest\n")))
+		_, err = NewFromEnv()
+		require.ErrorContains(t, err, "invalid digest")
+
+		require.NoError(t, userFile.WriteFile([]byte("deamon:\n  port: \"80\"\n")))
+		_, err = NewFromEnv()
+		require.ErrorContains(t, err, "invalid configuration file")
+	})
+}
@@ -0,0 +1,244 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/helpers"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+var ErrAppConflict = errors.New("app conflicts with a running app")
+
+// exclusiveDeviceClasses are the device classes that can be used by a single app at a time.
+var exclusiveDeviceClasses = []string{CameraDevice, MicrophoneDevice}
+
+// appResources are the host resources an app claims while running.
+type appResources struct {
+	usesSketch bool
+	ports      map[string]struct{}
+	devices    map[string]struct{}
+}
+
+func getAppResources(
+	a app.ArduinoApp,
+	bricksIndex *bricksindex.BricksIndex,
+	staticStore *store.StaticStore,
+) appResources {
+	res := appResources{
+		usesSketch: a.MainSketchPath != nil,
+		ports:      make(map[string]struct{}),
+		devices:    make(map[string]struct{}),
+	}
+
+	for _, p := range a.Descriptor.Ports {
+		res.ports[strconv.Itoa(p)] = struct{}{}
+	}
+	addDevices := func(classes []string) {
+		for _, class := range classes {
+			if slices.Contains(exclusiveDeviceClasses, class) {
+				res.devices[class] = struct{}{}
+			}
+		}
+	}
+	addDevices(a.Descriptor.RequiredDevices)
+
+	envs := make(helpers.EnvVars)
+	for _, brick := range a.Descriptor.Bricks {
+		idxBrick, found := bricksIndex.FindBrickByID(brick.ID)
+		if !found {
+			continue
+		}
+		maps.Insert(envs, idxBrick.GetDefaultVariables())
+		maps.Insert(envs, maps.All(brick.Variables))
+
+		for _, p := range idxBrick.Ports {
+			res.ports[p] = struct{}{}
+		}
+		addDevices(idxBrick.RequiredDevices)
+		for _, d := range idxBrick.ExclusiveDevices {
+			res.devices[d] = struct{}{}
+		}
+	}
+
+	// Brick containers may publish ports on the host, resolve them with the
+	// same variables used when the app is started.
+	for _, brick := range a.Descriptor.Bricks {
+		for _, hostPort := range brickHostPorts(brick.ID, bricksIndex, staticStore, envs) {
+			res.ports[hostPort] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// brickHostPorts returns the host ports published by the containers of a brick,
+// as declared by its compose file, with the variables expanded using envs.
+func brickHostPorts(
+	brickID string,
+	bricksIndex *bricksindex.BricksIndex,
+	staticStore *store.StaticStore,
+	envs helpers.EnvVars,
+) []string {
+	idxBrick, found := bricksIndex.FindBrickByID(brickID)
+	if !found || !idxBrick.RequireContainer || staticStore == nil {
+		return nil
+	}
+	composeFilePath, err := staticStore.GetBrickComposeFilePathFromID(brickID)
+	if err != nil {
+		return nil
+	}
+	svcs, err := extractServicesFromComposeFile(composeFilePath)
+	if err != nil {
+		return nil
+	}
+	var hostPorts []string
+	for _, name := range slices.Sorted(maps.Keys(svcs)) {
+		for _, p := range svcs[name].ports {
+			if hostPort, ok := parseComposeHostPort(p, envs); ok {
+				hostPorts = append(hostPorts, hostPort)
+			}
+		}
+	}
+	return hostPorts
+}
+
+// parseComposeHostPort returns the host port of a compose port mapping
+// in the short syntax ([HOST:]HOST_PORT:CONTAINER_PORT[/PROTOCOL]).
+// Compose variables are expanded using the given envs.
+func parseComposeHostPort(mapping string, envs helpers.EnvVars) (string, bool) {
+	mapping = os.Expand(mapping, func(v string) string {
+		name, def, hasDefault := strings.Cut(v, ":-")
+		if val, ok := envs[name]; ok && val != "" {
+			return val
+		}
+		if hasDefault {
+			return def
+		}
+		return ""
+	})
+	mapping, _, _ = strings.Cut(mapping, "/")
+	parts := strings.Split(mapping, ":")
+	if len(parts) < 2 {
+		// Only the container port is published, docker picks a random host port.
+		return "", false
+	}
+	hostPort := parts[len(parts)-2]
+	if hostPort == "" {
+		return "", false
+	}
+	return hostPort, true
+}
+
+// checkAppConflicts verifies that appToStart can run together with the
+// running apps. It returns an error wrapping ErrAppConflict describing the
+// first conflict found.
+func checkAppConflicts(
+	appToStart app.ArduinoApp,
+	running []app.ArduinoApp,
+	bricksIndex *bricksindex.BricksIndex,
+	staticStore *store.StaticStore,
+	cfg config.Configuration,
+) error {
+	for _, r := range running {
+		if r.FullPath.EqualsTo(appToStart.FullPath) {
+			return fmt.Errorf("app %q is running", r.Name)
+		}
+	}
+	if len(running) == 0 {
+		return nil
+	}
+	if !cfg.AllowMultipleApps {
+		return fmt.Errorf("app %q is running", running[0].Name)
+	}
+
+	toStart := getAppResources(appToStart, bricksIndex, staticStore)
+	for _, r := range running {
+		other := getAppResources(r, bricksIndex, staticStore)
+
+		// There is a single MCU, so only Python apps can run side by side.
+		if toStart.usesSketch || other.usesSketch {
+			return fmt.Errorf("%w: the MCU sketch slot is used by %q, only apps without a sketch can run concurrently", ErrAppConflict, r.Name)
+		}
+		for _, p := range slices.Sorted(maps.Keys(toStart.ports)) {
+			if _, ok := other.ports[p]; ok {
+				return fmt.Errorf("%w: port %s is already used by %q", ErrAppConflict, p, r.Name)
+			}
+		}
+		for _, d := range slices.Sorted(maps.Keys(toStart.devices)) {
+			if _, ok := other.devices[d]; ok {
+				return fmt.Errorf("%w: device %s is already used by %q", ErrAppConflict, d, r.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// checkAppBricks verifies that the bricks of the app can be used together, so that
+// an invalid app fails with a clear message before docker compose is invoked.
+func checkAppBricks(
+	a *app.ArduinoApp,
+	bricksIndex *bricksindex.BricksIndex,
+	staticStore *store.StaticStore,
+	envs helpers.EnvVars,
+) error {
+	ids := f.Map(a.Descriptor.Bricks, func(b app.Brick) string { return b.ID })
+	missing, err := bricksIndex.MissingDependencies(ids)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the app requires the bricks %s, add them to the app", strings.Join(missing, ", "))
+	}
+	if err := bricksIndex.CheckConflicts(ids); err != nil {
+		return err
+	}
+
+	// The ports published by the main container, and the ones published by the brick containers,
+	// must not collide.
+	portOwners := make(map[string]string)
+	for _, p := range a.Descriptor.Ports {
+		portOwners[strconv.Itoa(p)] = "the app"
+	}
+	for _, brick := range a.Descriptor.Bricks {
+		if idxBrick, found := bricksIndex.FindBrickByID(brick.ID); found {
+			for _, p := range idxBrick.Ports {
+				portOwners[p] = "the app"
+			}
+		}
+	}
+	for _, brick := range a.Descriptor.Bricks {
+		for _, hostPort := range brickHostPorts(brick.ID, bricksIndex, staticStore, envs) {
+			if owner, used := portOwners[hostPort]; used {
+				return fmt.Errorf("%w: port %s of the brick %q is already used by %s", bricksindex.ErrBrickConflict, hostPort, brick.ID, owner)
+			}
+			portOwners[hostPort] = fmt.Sprintf("the brick %q", brick.ID)
+		}
+	}
+	return nil
+}
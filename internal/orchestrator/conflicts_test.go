@@ -0,0 +1,231 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/helpers"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+func TestParseComposeHostPort(t *testing.T) {
+	envs := helpers.EnvVars{"BIND_PORT": "9000"}
+	testCases := []struct {
+		mapping  string
+		expected string
+		found    bool
+	}{
+		{"8080", "", false},
+		{"8080:80", "8080", true},
+		{"127.0.0.1:8080:80/tcp", "8080", true},
+		{"${BIND_ADDRESS:-127.0.0.1}:${BIND_PORT:-1337}:1337", "9000", true},
+		{"${BIND_ADDRESS:-127.0.0.1}:${OTHER_PORT:-1337}:1337", "1337", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.mapping, func(t *testing.T) {
+			port, ok := parseComposeHostPort(tc.mapping, envs)
+			require.Equal(t, tc.found, ok)
+			require.Equal(t, tc.expected, port)
+		})
+	}
+}
+
+func TestCheckAppConflicts(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	cfg.AllowMultipleApps = true
+
+	bricksIndexContent := []byte(`
+bricks:
+- id: arduino:object_detection
+  name: Object Detection
+  require_container: true
+  category: video
+  required_devices:
+  - camera
+- id: arduino:web_ui
+  name: Web UI
+  require_container: false
+  ports:
+  - "7000"
+`)
+	require.NoError(t, cfg.AssetsDir().Join("bricks-list.yaml").WriteFile(bricksIndexContent))
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+
+	composeDir := cfg.AssetsDir().Join("compose", "arduino", "object_detection")
+	require.NoError(t, composeDir.MkdirAll())
+	require.NoError(t, composeDir.Join("brick_compose.yaml").WriteFile([]byte(`
+services:
+  ei-obj-detection-runner:
+    image: ei-models-runner
+    ports:
+      - ${BIND_ADDRESS:-127.0.0.1}:${BIND_PORT:-1337}:1337
+`)))
+	staticStore := store.NewStaticStore(cfg.AssetsDir().String())
+
+	newApp := func(name string, withSketch bool, ports []int, bricks ...app.Brick) app.ArduinoApp {
+		a := app.ArduinoApp{
+			Name:           name,
+			FullPath:       paths.New(t.TempDir(), name),
+			MainPythonFile: paths.New("main.py"),
+			Descriptor: app.AppDescriptor{
+				Name:   name,
+				Ports:  ports,
+				Bricks: bricks,
+			},
+		}
+		if withSketch {
+			a.MainSketchPath = paths.New("sketch")
+		}
+		return a
+	}
+
+	python1 := newApp("python1", false, []int{8080})
+	python2 := newApp("python2", false, []int{8081})
+	webUI := newApp("webui", false, nil, app.Brick{ID: "arduino:web_ui"})
+	detection1 := newApp("detection1", false, nil, app.Brick{ID: "arduino:object_detection", Variables: map[string]string{"BIND_PORT": "2000"}})
+	detection2 := newApp("detection2", false, nil, app.Brick{ID: "arduino:object_detection", Variables: map[string]string{"BIND_PORT": "3000"}})
+	withSketch := newApp("sketch", true, nil)
+
+	t.Run("no running apps", func(t *testing.T) {
+		require.NoError(t, checkAppConflicts(withSketch, nil, bricksIndex, staticStore, cfg))
+	})
+
+	t.Run("same app already running", func(t *testing.T) {
+		err := checkAppConflicts(python1, []app.ArduinoApp{python1}, bricksIndex, staticStore, cfg)
+		require.ErrorContains(t, err, `app "python1" is running`)
+	})
+
+	t.Run("multiple apps disabled", func(t *testing.T) {
+		cfg := cfg
+		cfg.AllowMultipleApps = false
+		err := checkAppConflicts(python2, []app.ArduinoApp{python1}, bricksIndex, staticStore, cfg)
+		require.ErrorContains(t, err, `app "python1" is running`)
+		require.NotErrorIs(t, err, ErrAppConflict)
+	})
+
+	t.Run("python apps without conflicts", func(t *testing.T) {
+		require.NoError(t, checkAppConflicts(python2, []app.ArduinoApp{python1, webUI}, bricksIndex, staticStore, cfg))
+	})
+
+	t.Run("sketch slot", func(t *testing.T) {
+		err := checkAppConflicts(withSketch, []app.ArduinoApp{python1}, bricksIndex, staticStore, cfg)
+		require.ErrorIs(t, err, ErrAppConflict)
+		require.ErrorContains(t, err, "MCU sketch slot")
+
+		err = checkAppConflicts(python1, []app.ArduinoApp{withSketch}, bricksIndex, staticStore, cfg)
+		require.ErrorIs(t, err, ErrAppConflict)
+	})
+
+	t.Run("app port conflict", func(t *testing.T) {
+		other := newApp("other", false, []int{8080})
+		err := checkAppConflicts(other, []app.ArduinoApp{python1}, bricksIndex, staticStore, cfg)
+		require.ErrorIs(t, err, ErrAppConflict)
+		require.ErrorContains(t, err, `port 8080 is already used by "python1"`)
+	})
+
+	t.Run("brick port conflict", func(t *testing.T) {
+		other := newApp("other", false, []int{7000})
+		err := checkAppConflicts(other, []app.ArduinoApp{webUI}, bricksIndex, staticStore, cfg)
+		require.ErrorIs(t, err, ErrAppConflict)
+		require.ErrorContains(t, err, "port 7000")
+	})
+
+	t.Run("brick compose port conflict", func(t *testing.T) {
+		other := newApp("other", false, []int{2000})
+		err := checkAppConflicts(other, []app.ArduinoApp{detection1}, bricksIndex, staticStore, cfg)
+		require.ErrorIs(t, err, ErrAppConflict)
+		require.ErrorContains(t, err, "port 2000")
+	})
+
+	t.Run("exclusive device conflict", func(t *testing.T) {
+		err := checkAppConflicts(detection2, []app.ArduinoApp{detection1}, bricksIndex, staticStore, cfg)
+		require.ErrorIs(t, err, ErrAppConflict)
+		require.ErrorContains(t, err, `device camera is already used by "detection1"`)
+	})
+}
+
+func TestCheckAppBricks(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+
+	bricksIndexContent := []byte(`
+bricks:
+- id: arduino:object_detection
+  name: Object Detection
+  require_container: true
+- id: arduino:video_objectdetection
+  name: Video Object Detection
+  depends_on:
+  - arduino:usb_camera
+- id: arduino:usb_camera
+  name: USB Camera
+  exclusive_devices:
+  - camera
+- id: arduino:camera_snapshot
+  name: Camera Snapshot
+  exclusive_devices:
+  - camera
+`)
+	require.NoError(t, cfg.AssetsDir().Join("bricks-list.yaml").WriteFile(bricksIndexContent))
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+
+	composeDir := cfg.AssetsDir().Join("compose", "arduino", "object_detection")
+	require.NoError(t, composeDir.MkdirAll())
+	require.NoError(t, composeDir.Join("brick_compose.yaml").WriteFile([]byte(`
+services:
+  ei-obj-detection-runner:
+    image: ei-models-runner
+    ports:
+      - ${BIND_ADDRESS:-127.0.0.1}:${BIND_PORT:-1337}:1337
+`)))
+	staticStore := store.NewStaticStore(cfg.AssetsDir().String())
+
+	newApp := func(ports []int, bricks ...app.Brick) *app.ArduinoApp {
+		return &app.ArduinoApp{Descriptor: app.AppDescriptor{Ports: ports, Bricks: bricks}}
+	}
+
+	t.Run("valid bricks", func(t *testing.T) {
+		a := newApp([]int{8080}, app.Brick{ID: "arduino:object_detection"}, app.Brick{ID: "arduino:video_objectdetection"}, app.Brick{ID: "arduino:usb_camera"})
+		require.NoError(t, checkAppBricks(a, bricksIndex, staticStore, helpers.EnvVars{}))
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		a := newApp(nil, app.Brick{ID: "arduino:video_objectdetection"})
+		err := checkAppBricks(a, bricksIndex, staticStore, helpers.EnvVars{})
+		require.EqualError(t, err, "the app requires the bricks arduino:usb_camera, add them to the app")
+	})
+
+	t.Run("exclusive device", func(t *testing.T) {
+		a := newApp(nil, app.Brick{ID: "arduino:usb_camera"}, app.Brick{ID: "arduino:camera_snapshot"})
+		err := checkAppBricks(a, bricksIndex, staticStore, helpers.EnvVars{})
+		require.ErrorIs(t, err, bricksindex.ErrBrickConflict)
+	})
+
+	t.Run("port used by the app and by a brick container", func(t *testing.T) {
+		a := newApp([]int{1337}, app.Brick{ID: "arduino:object_detection"})
+		err := checkAppBricks(a, bricksIndex, staticStore, helpers.EnvVars{})
+		require.EqualError(t, err, `bricks conflict: port 1337 of the brick "arduino:object_detection" is already used by the app`)
+
+		require.NoError(t, checkAppBricks(a, bricksIndex, staticStore, helpers.EnvVars{"BIND_PORT": "2000"}))
+	})
+}
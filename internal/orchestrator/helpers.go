@@ -105,36 +105,27 @@ func parseAppStatus(containers []container.Summary) []AppStatusInfo {
 func getAppsStatus(
 	ctx context.Context,
 	docker dockerClient.APIClient,
+	cfg config.Configuration,
 ) ([]AppStatusInfo, error) {
-	getPythonApp := func() ([]AppStatusInfo, error) {
-		containers, err := docker.ContainerList(ctx, container.ListOptions{
-			All:     true,
-			Filters: filters.NewArgs(filters.Arg("label", DockerAppLabel+"=true")),
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list containers: %w", err)
-		}
-		if len(containers) == 0 {
-			return nil, nil
-		}
-		return parseAppStatus(containers), nil
+	containers, err := docker.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", DockerAppLabel+"=true")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
 	}
+	apps := parseAppStatus(containers)
 
-	getSketchApp := func() ([]AppStatusInfo, error) {
-		// TODO: implement this function
-		return nil, nil
+	sketchApp, err := getSketchAppStatus(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sketch status: %w", err)
 	}
-
-	for _, get := range [](func() ([]AppStatusInfo, error)){getPythonApp, getSketchApp} {
-		apps, err := get()
-		if err != nil {
-			return nil, err
-		}
-		if len(apps) != 0 {
-			return apps, nil
-		}
+	if sketchApp != nil && !slices.ContainsFunc(apps, func(a AppStatusInfo) bool {
+		return a.AppPath.EqualsTo(sketchApp.AppPath)
+	}) {
+		apps = append(apps, *sketchApp)
 	}
-	return nil, nil
+	return apps, nil
 }
 
 func getAppStatusByPath(
@@ -165,8 +156,9 @@ func getAppStatus(
 	ctx context.Context,
 	docker command.Cli,
 	app app.ArduinoApp,
+	cfg config.Configuration,
 ) (AppStatusInfo, error) {
-	apps, err := getAppsStatus(ctx, docker.Client())
+	apps, err := getAppsStatus(ctx, docker.Client(), cfg)
 	if err != nil {
 		return AppStatusInfo{}, fmt.Errorf("failed to get app status: %w", err)
 	}
@@ -179,25 +171,27 @@ func getAppStatus(
 	return apps[idx], nil
 }
 
-func getRunningApp(
+func getRunningApps(
 	ctx context.Context,
 	docker dockerClient.APIClient,
-) (*app.ArduinoApp, error) {
-	apps, err := getAppsStatus(ctx, docker)
+	cfg config.Configuration,
+) ([]app.ArduinoApp, error) {
+	apps, err := getAppsStatus(ctx, docker, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get running apps: %w", err)
 	}
-	idx := slices.IndexFunc(apps, func(a AppStatusInfo) bool {
-		return a.Status == StatusRunning || a.Status == StatusStarting
-	})
-	if idx == -1 {
-		return nil, nil
-	}
-	app, err := app.Load(apps[idx].AppPath.String())
-	if err != nil {
-		return nil, fmt.Errorf("failed to load running app: %w", err)
+	var running []app.ArduinoApp
+	for _, a := range apps {
+		if a.Status != StatusRunning && a.Status != StatusStarting {
+			continue
+		}
+		app, err := app.Load(a.AppPath.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load running app: %w", err)
+		}
+		running = append(running, app)
 	}
-	return &app, nil
+	return running, nil
 }
 
 func getAppComposeProjectNameFromApp(app app.ArduinoApp, cfg config.Configuration) (string, error) {
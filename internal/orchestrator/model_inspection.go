@@ -0,0 +1,126 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/docker/docker/api/types/container"
+	dockerClient "github.com/docker/docker/client"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+const modelInspectionTimeout = 10 * time.Second
+
+// Resources granted to the container inspecting an uploaded model.
+const (
+	modelInspectionMemory    = 512 * 1024 * 1024
+	modelInspectionNanoCPUs  = 1_000_000_000
+	modelInspectionPidsLimit = 64
+)
+
+// inspectAIModel reads the parameters of an uploaded .eim model. The model is never run on
+// the host: it is started in the runner container of the brick, without network, with a
+// read-only file system and limited resources. Only the socket used to ask the parameters
+// is shared with the host.
+func inspectAIModel(
+	ctx context.Context,
+	docker dockerClient.APIClient,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+	brickID string,
+	eim *paths.Path,
+) (*modelsindex.ModelParameters, error) {
+	image, err := modelRunnerImage(cfg, staticStore, brickID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, modelInspectionTimeout)
+	defer cancel()
+
+	socketDir, err := paths.MkTempDir("", "eim-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = socketDir.RemoveAll() }()
+
+	pidsLimit := int64(modelInspectionPidsLimit)
+	resp, err := docker.ContainerCreate(ctx,
+		&container.Config{
+			Image:           image,
+			User:            getCurrentUser(),
+			Entrypoint:      []string{"/model/model.eim", "/run/eim/runner.sock"},
+			NetworkDisabled: true,
+		},
+		&container.HostConfig{
+			NetworkMode: "none",
+			Binds: []string{
+				eim.String() + ":/model/model.eim:ro",
+				socketDir.String() + ":/run/eim",
+			},
+			ReadonlyRootfs: true,
+			CapDrop:        []string{"ALL"},
+			SecurityOpt:    []string{"no-new-privileges"},
+			Resources: container.Resources{
+				Memory:    modelInspectionMemory,
+				NanoCPUs:  modelInspectionNanoCPUs,
+				PidsLimit: &pidsLimit,
+			},
+		},
+		nil, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("cannot create the model container: %w", err)
+	}
+	defer func() {
+		// The context of the request may be expired, the container is removed anyway.
+		if err := docker.ContainerRemove(context.WithoutCancel(ctx), resp.ID, container.RemoveOptions{Force: true}); err != nil {
+			slog.Warn("unable to remove the model container", slog.String("container_id", resp.ID), slog.String("error", err.Error()))
+		}
+	}()
+	if err := docker.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		return nil, fmt.Errorf("cannot run the model: %w", err)
+	}
+
+	return modelsindex.ReadEIMParameters(ctx, socketDir.Join("runner.sock"))
+}
+
+// modelRunnerImage returns the image of the container that runs the models of the brick.
+func modelRunnerImage(cfg config.Configuration, staticStore *store.StaticStore, brickID string) (string, error) {
+	composeFile, err := staticStore.GetBrickComposeFilePathFromID(brickID)
+	if err != nil {
+		return "", err
+	}
+	services, err := extractServicesFromComposeFile(composeFile)
+	if err != nil {
+		return "", fmt.Errorf("cannot read the compose file of brick %q: %w", brickID, err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		if image := services[name].image; image != "" {
+			return cfg.Images.Resolve(image), nil
+		}
+	}
+	return "", fmt.Errorf("brick %q has no container to run the model", brickID)
+}
@@ -16,7 +16,35 @@
 package orchestrator
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/arduino/go-paths-helper"
+	dockerClient "github.com/docker/docker/client"
+	"github.com/gosimple/slug"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+// MaxAIModelSize is the maximum size of an uploaded model file.
+const MaxAIModelSize = 256 * 1024 * 1024
+
+// maxAIModelSize is the limit enforced by AddAIModel, it is lowered by the tests.
+var maxAIModelSize int64 = MaxAIModelSize
+
+var (
+	ErrModelNotFound     = errors.New("model not found")
+	ErrInvalidModel      = errors.New("invalid model")
+	ErrModelNotRemovable = errors.New("only custom models can be removed")
+	ErrAIModelTooLarge   = errors.New("model too large")
 )
 
 type AIModelsListResult struct {
@@ -31,6 +59,17 @@ type AIModelItem struct {
 	Bricks             []string          `json:"brick_ids"`
 	Metadata           map[string]string `json:"metadata,omitempty"`
 	ModelConfiguration map[string]string `json:"model_configuration,omitempty"`
+
+	// Parameters and Compatibility are reported only in the details of a model.
+	Parameters    *modelsindex.ModelParameters `json:"parameters,omitempty"`
+	Compatibility []BrickCompatibility         `json:"compatibility,omitempty"`
+}
+
+// BrickCompatibility reports if a model can be used by one of its bricks.
+type BrickCompatibility struct {
+	BrickID    string `json:"brick_id"`
+	Compatible bool   `json:"compatible"`
+	Reason     string `json:"reason,omitempty"`
 }
 
 type AIModelsListRequest struct {
@@ -46,24 +85,39 @@ func AIModelsList(req AIModelsListRequest, modelsIndex *modelsindex.ModelsIndex)
 	}
 	res := AIModelsListResult{Models: make([]AIModelItem, len(collection))}
 	for i, model := range collection {
-		res.Models[i] = AIModelItem{
-			ID:                 model.ID,
-			Name:               model.Name,
-			ModuleDescription:  model.ModuleDescription,
-			Runner:             model.Runner,
-			Bricks:             model.Bricks,
-			Metadata:           model.Metadata,
-			ModelConfiguration: model.ModelConfiguration,
-		}
+		res.Models[i] = toAIModelItem(model)
 	}
 	return res
 }
 
-func AIModelDetails(modelsIndex *modelsindex.ModelsIndex, id string) (AIModelItem, bool) {
+// AIModelDetails returns the model with the parameters found by inspecting it, and checks
+// if it is compatible with each of its bricks.
+func AIModelDetails(modelsIndex *modelsindex.ModelsIndex, bricksIndex *bricksindex.BricksIndex, id string) (AIModelItem, bool) {
 	model, found := modelsIndex.GetModelByID(id)
 	if !found {
 		return AIModelItem{}, false
 	}
+	res := toAIModelItem(*model)
+	params := modelsindex.Inspect(*model)
+	res.Parameters = &params
+	res.Compatibility = make([]BrickCompatibility, len(model.Bricks))
+	for i, brickID := range model.Bricks {
+		res.Compatibility[i] = BrickCompatibility{BrickID: brickID, Compatible: true}
+		brick, found := bricksIndex.FindBrickByID(brickID)
+		if !found {
+			res.Compatibility[i].Compatible = false
+			res.Compatibility[i].Reason = "brick not found"
+			continue
+		}
+		if err := modelsindex.CheckBrickCompatibility(*model, brick); err != nil {
+			res.Compatibility[i].Compatible = false
+			res.Compatibility[i].Reason = err.Error()
+		}
+	}
+	return res, true
+}
+
+func toAIModelItem(model modelsindex.AIModel) AIModelItem {
 	return AIModelItem{
 		ID:                 model.ID,
 		Name:               model.Name,
@@ -72,5 +126,162 @@ func AIModelDetails(modelsIndex *modelsindex.ModelsIndex, id string) (AIModelIte
 		Bricks:             model.Bricks,
 		Metadata:           model.Metadata,
 		ModelConfiguration: model.ModelConfiguration,
-	}, true
+	}
+}
+
+type AddAIModelRequest struct {
+	// ID of the model, derived from the name if empty.
+	ID          string
+	Name        string
+	Description string
+	Bricks      []string
+	Labels      []string
+}
+
+// AddAIModel stores a custom Edge Impulse model in the custom models folder and adds it to the
+// index. The variables used by the target bricks to select the model file are taken from their
+// default models, so that the custom model is passed to the bricks in the same way.
+// The model is inspected, when possible, to check that it is compatible with the bricks.
+func AddAIModel(
+	ctx context.Context,
+	docker dockerClient.APIClient,
+	cfg config.Configuration,
+	modelsIndex *modelsindex.ModelsIndex,
+	bricksIndex *bricksindex.BricksIndex,
+	staticStore *store.StaticStore,
+	req AddAIModelRequest,
+	eim io.Reader,
+) (AIModelItem, error) {
+	id := req.ID
+	if id == "" {
+		id = slug.Make(req.Name)
+	}
+	if id == "" || id != slug.Make(id) {
+		return AIModelItem{}, fmt.Errorf("%w: the id %q must be made of lowercase letters, digits and dashes", ErrInvalidModel, id)
+	}
+	name := req.Name
+	if name == "" {
+		name = id
+	}
+	if len(req.Bricks) == 0 {
+		return AIModelItem{}, fmt.Errorf("%w: at least a target brick is required", ErrInvalidModel)
+	}
+	if _, found := modelsIndex.GetModelByID(id); found {
+		return AIModelItem{}, fmt.Errorf("%w: %s", modelsindex.ErrModelAlreadyExists, id)
+	}
+
+	dir := cfg.CustomEIModelsDir()
+	file := dir.Join(id + ".eim")
+	configuration := make(map[string]string)
+	for _, brickID := range req.Bricks {
+		brick, found := bricksIndex.FindBrickByID(brickID)
+		if !found {
+			return AIModelItem{}, fmt.Errorf("%w: brick %q not found", ErrInvalidModel, brickID)
+		}
+		defaultModel, found := modelsIndex.GetModelByID(brick.ModelName)
+		if !brick.RequireModel || !found || len(defaultModel.ModelConfiguration) == 0 {
+			return AIModelItem{}, fmt.Errorf("%w: brick %q does not support custom models", ErrInvalidModel, brickID)
+		}
+		for name := range defaultModel.ModelConfiguration {
+			configuration[name] = file.String()
+		}
+		// The custom models folder is mounted in the brick container.
+		if _, found := brick.GetVariable("CUSTOM_MODEL_PATH"); found {
+			configuration["CUSTOM_MODEL_PATH"] = dir.String()
+		}
+	}
+
+	if err := dir.MkdirAll(); err != nil {
+		return AIModelItem{}, err
+	}
+	tmp := dir.Join(id + ".eim.tmp")
+	defer func() { _ = tmp.Remove() }()
+	// The .eim files are executables run by the bricks.
+	out, err := os.OpenFile(tmp.String(), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return AIModelItem{}, fmt.Errorf("cannot store the model file: %w", err)
+	}
+	size, err := io.Copy(out, io.LimitReader(eim, maxAIModelSize+1))
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return AIModelItem{}, fmt.Errorf("cannot store the model file: %w", err)
+	}
+	if size > maxAIModelSize {
+		return AIModelItem{}, fmt.Errorf("%w: the model file exceeds %d bytes", ErrAIModelTooLarge, maxAIModelSize)
+	}
+	if size == 0 {
+		return AIModelItem{}, fmt.Errorf("%w: the model file is empty", ErrInvalidModel)
+	}
+
+	// The model can be run only on the board, elsewhere its parameters stay unknown.
+	var params *modelsindex.ModelParameters
+	if docker != nil {
+		params, err = inspectAIModel(ctx, docker, cfg, staticStore, req.Bricks[0], tmp)
+		if err != nil {
+			slog.Warn("unable to inspect the model", slog.String("model", id), slog.String("error", err.Error()))
+		}
+	}
+	labels := req.Labels
+	if len(labels) == 0 && params != nil {
+		labels = params.Labels
+	}
+	candidate := modelsindex.AIModel{ID: id, Bricks: req.Bricks, ModelLabels: labels, Parameters: params}
+	for _, brickID := range req.Bricks {
+		brick, _ := bricksIndex.FindBrickByID(brickID)
+		if err := modelsindex.CheckBrickCompatibility(candidate, brick); err != nil {
+			return AIModelItem{}, fmt.Errorf("%w: %w", ErrInvalidModel, err)
+		}
+	}
+
+	metadata, err := json.MarshalIndent(modelsindex.CustomModelMetadata{
+		Name:               name,
+		Description:        req.Description,
+		Bricks:             req.Bricks,
+		Labels:             labels,
+		ModelConfiguration: configuration,
+		ModelParameters:    params,
+	}, "", "  ")
+	if err != nil {
+		return AIModelItem{}, err
+	}
+	sidecar := dir.Join(id + ".json")
+	if err := tmp.Rename(file); err != nil {
+		return AIModelItem{}, err
+	}
+	if err := sidecar.WriteFile(metadata); err != nil {
+		_ = file.Remove()
+		return AIModelItem{}, err
+	}
+
+	model, err := modelsindex.LoadCustomModel(sidecar)
+	if err == nil {
+		err = modelsIndex.AddModel(*model)
+	}
+	if err != nil {
+		_ = file.Remove()
+		_ = sidecar.Remove()
+		return AIModelItem{}, err
+	}
+	return toAIModelItem(*model), nil
+}
+
+// RemoveAIModel deletes a custom model from the custom models folder and from the index.
+func RemoveAIModel(modelsIndex *modelsindex.ModelsIndex, id string) error {
+	model, found := modelsIndex.GetModelByID(id)
+	if !found {
+		return ErrModelNotFound
+	}
+	if !model.IsCustom() {
+		return ErrModelNotRemovable
+	}
+	sidecar := model.File.Parent().Join(id + ".json")
+	for _, f := range []*paths.Path{sidecar, model.File} {
+		if err := f.Remove(); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("cannot remove the model: %w", err)
+		}
+	}
+	modelsIndex.RemoveModel(id)
+	return nil
 }
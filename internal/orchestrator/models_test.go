@@ -0,0 +1,132 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func TestAddAndRemoveAIModel(t *testing.T) {
+	t.Setenv("ARDUINO_APP_BRICKS__CUSTOM_MODEL_DIR", paths.New(t.TempDir()).Join("models").String())
+	cfg := setTestOrchestratorConfig(t)
+
+	require.NoError(t, cfg.AssetsDir().Join("bricks-list.yaml").WriteFile([]byte(`
+bricks:
+- id: arduino:object_detection
+  name: Object Detection
+  require_container: true
+  require_model: true
+  model_name: yolox-object-detection
+  variables:
+  - name: CUSTOM_MODEL_PATH
+    default_value: /models/custom/ei/
+- id: arduino:web_ui
+  name: Web UI
+  require_container: false
+`)))
+	require.NoError(t, cfg.AssetsDir().Join("models-list.yaml").WriteFile([]byte(`
+models:
+ - yolox-object-detection:
+    runner: brick
+    name: "YoloX"
+    model_configuration:
+      "EI_OBJ_DETECTION_MODEL": "/models/ootb/ei/yolo-x-nano.eim"
+    bricks:
+      - arduino:object_detection
+`)))
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+
+	dir := cfg.CustomEIModelsDir()
+	res, err := AddAIModel(t.Context(), nil, cfg, modelsIndex, bricksIndex, nil, AddAIModelRequest{
+		Name:   "My Cats",
+		Bricks: []string{"arduino:object_detection"},
+		Labels: []string{"cat"},
+	}, strings.NewReader("eim"))
+	require.NoError(t, err)
+	require.Equal(t, "my-cats", res.ID)
+	require.Equal(t, "My Cats", res.Name)
+	require.Equal(t, "custom", res.Metadata["source"])
+	require.Equal(t, map[string]string{
+		"EI_OBJ_DETECTION_MODEL": dir.Join("my-cats.eim").String(),
+		"CUSTOM_MODEL_PATH":      dir.String(),
+	}, res.ModelConfiguration)
+	require.FileExists(t, dir.Join("my-cats.eim").String())
+	require.FileExists(t, dir.Join("my-cats.json").String())
+
+	// The model is loaded again from the custom models folder.
+	reloaded, err := modelsindex.GenerateModelsIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+	require.NoError(t, reloaded.AddCustomModels(dir))
+	model, found := reloaded.GetModelByID("my-cats")
+	require.True(t, found)
+	require.Equal(t, []string{"cat"}, model.ModelLabels)
+
+	t.Run("invalid requests", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  AddAIModelRequest
+			eim  string
+			err  error
+		}{
+			{"already exists", AddAIModelRequest{Name: "My Cats", Bricks: []string{"arduino:object_detection"}}, "eim", modelsindex.ErrModelAlreadyExists},
+			{"invalid id", AddAIModelRequest{ID: "My Cats", Bricks: []string{"arduino:object_detection"}}, "eim", ErrInvalidModel},
+			{"no bricks", AddAIModelRequest{Name: "dogs"}, "eim", ErrInvalidModel},
+			{"unknown brick", AddAIModelRequest{Name: "dogs", Bricks: []string{"arduino:unknown"}}, "eim", ErrInvalidModel},
+			{"brick without models", AddAIModelRequest{Name: "dogs", Bricks: []string{"arduino:web_ui"}}, "eim", ErrInvalidModel},
+			{"empty file", AddAIModelRequest{Name: "dogs", Bricks: []string{"arduino:object_detection"}}, "", ErrInvalidModel},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := AddAIModel(t.Context(), nil, cfg, modelsIndex, bricksIndex, nil, tc.req, strings.NewReader(tc.eim))
+				require.ErrorIs(t, err, tc.err)
+			})
+		}
+		require.NoFileExists(t, dir.Join("dogs.eim").String())
+	})
+
+	t.Run("reject too large models", func(t *testing.T) {
+		defer func(size int64) { maxAIModelSize = size }(maxAIModelSize)
+		maxAIModelSize = 2
+
+		_, err := AddAIModel(t.Context(), nil, cfg, modelsIndex, bricksIndex, nil, AddAIModelRequest{
+			Name:   "dogs",
+			Bricks: []string{"arduino:object_detection"},
+		}, strings.NewReader("eim"))
+		require.ErrorIs(t, err, ErrAIModelTooLarge)
+		require.NoFileExists(t, dir.Join("dogs.eim").String())
+		require.NoFileExists(t, dir.Join("dogs.eim.tmp").String())
+	})
+
+	require.ErrorIs(t, RemoveAIModel(modelsIndex, "yolox-object-detection"), ErrModelNotRemovable)
+	require.ErrorIs(t, RemoveAIModel(modelsIndex, "unknown"), ErrModelNotFound)
+
+	require.NoError(t, RemoveAIModel(modelsIndex, "my-cats"))
+	require.NoFileExists(t, dir.Join("my-cats.eim").String())
+	require.NoFileExists(t, dir.Join("my-cats.json").String())
+	_, found = modelsIndex.GetModelByID("my-cats")
+	assert.False(t, found)
+}
@@ -0,0 +1,159 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package modelsindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+var ErrIncompatibleModel = errors.New("incompatible model")
+
+// Sensors that feed the input of a model.
+const (
+	SensorCamera        = "camera"
+	SensorMicrophone    = "microphone"
+	SensorAccelerometer = "accelerometer"
+	SensorPositional    = "positional"
+)
+
+// ModelParameters describes the input and the output of a model. Unknown values are left empty.
+type ModelParameters struct {
+	Sensor             string   `json:"sensor,omitempty"`
+	ModelType          string   `json:"model_type,omitempty"`
+	ImageInputWidth    int      `json:"image_input_width,omitempty"`
+	ImageInputHeight   int      `json:"image_input_height,omitempty"`
+	ImageChannelCount  int      `json:"image_channel_count,omitempty"`
+	InputFeaturesCount int      `json:"input_features_count,omitempty"`
+	Frequency          float64  `json:"frequency,omitempty"`
+	Labels             []string `json:"labels,omitempty"`
+	GPUAcceleration    bool     `json:"gpu_acceleration,omitempty"`
+}
+
+// Inspect returns the parameters of the model. The parameters read from the model file of
+// custom models are completed with the labels and the metadata of the index.
+func Inspect(model AIModel) ModelParameters {
+	var res ModelParameters
+	if model.Parameters != nil {
+		res = *model.Parameters
+		res.Labels = slices.Clone(model.Parameters.Labels)
+	}
+	if len(res.Labels) == 0 {
+		res.Labels = slices.Clone(model.ModelLabels)
+	}
+	if gpu, err := strconv.ParseBool(model.Metadata["ei-gpu-mode"]); err == nil && gpu {
+		res.GPUAcceleration = true
+	}
+	return res
+}
+
+// CheckBrickCompatibility returns an error wrapping ErrIncompatibleModel if the model cannot
+// be used by the brick. Parameters that are unknown, for the model or the brick, are not checked.
+func CheckBrickCompatibility(model AIModel, brick *bricksindex.Brick) error {
+	if !slices.Contains(model.Bricks, brick.ID) {
+		return fmt.Errorf("%w: model %q cannot be used by brick %q", ErrIncompatibleModel, model.ID, brick.ID)
+	}
+	params := Inspect(model)
+	if sensor := brick.GetModelSensor(); sensor != "" && params.Sensor != "" && sensor != params.Sensor {
+		return fmt.Errorf("%w: model %q reads from a %s, but brick %q provides data from a %s", ErrIncompatibleModel, model.ID, params.Sensor, brick.ID, sensor)
+	}
+	if brick.ModelType != "" && params.ModelType != "" && brick.ModelType != params.ModelType {
+		return fmt.Errorf("%w: model %q is a %s model, but brick %q requires a %s model", ErrIncompatibleModel, model.ID, params.ModelType, brick.ID, brick.ModelType)
+	}
+	if params.Sensor == SensorCamera && params.ImageChannelCount != 0 && params.ImageChannelCount != 1 && params.ImageChannelCount != 3 {
+		return fmt.Errorf("%w: model %q requires images with %d channels", ErrIncompatibleModel, model.ID, params.ImageChannelCount)
+	}
+	return nil
+}
+
+// eimHelloResponse is the answer of an .eim model to the hello message.
+type eimHelloResponse struct {
+	Success         bool   `json:"success"`
+	Error           string `json:"error"`
+	ModelParameters struct {
+		Sensor             int      `json:"sensor"`
+		ModelType          string   `json:"model_type"`
+		ImageInputWidth    int      `json:"image_input_width"`
+		ImageInputHeight   int      `json:"image_input_height"`
+		ImageChannelCount  int      `json:"image_channel_count"`
+		InputFeaturesCount int      `json:"input_features_count"`
+		Frequency          float64  `json:"frequency"`
+		Labels             []string `json:"labels"`
+	} `json:"model_parameters"`
+}
+
+// eimSensors maps the sensor codes of the Edge Impulse models to their names.
+var eimSensors = map[int]string{
+	1: SensorMicrophone,
+	2: SensorAccelerometer,
+	3: SensorCamera,
+	4: SensorPositional,
+}
+
+// ReadEIMParameters asks the parameters of a running .eim model through the socket used by
+// the Edge Impulse runners. It waits for the model to listen on the socket until ctx expires.
+func ReadEIMParameters(ctx context.Context, socket *paths.Path) (*ModelParameters, error) {
+	var dialer net.Dialer
+	var conn net.Conn
+	for {
+		var err error
+		conn, err = dialer.DialContext(ctx, "unix", socket.String())
+		if err == nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("the model did not answer: %w", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if err := json.NewEncoder(conn).Encode(map[string]int{"hello": 1, "id": 1}); err != nil {
+		return nil, err
+	}
+	var res eimHelloResponse
+	if err := json.NewDecoder(conn).Decode(&res); err != nil {
+		return nil, fmt.Errorf("invalid answer from the model: %w", err)
+	}
+	if !res.Success {
+		return nil, fmt.Errorf("the model returned an error: %s", res.Error)
+	}
+	p := res.ModelParameters
+	return &ModelParameters{
+		Sensor:             eimSensors[p.Sensor],
+		ModelType:          p.ModelType,
+		ImageInputWidth:    p.ImageInputWidth,
+		ImageInputHeight:   p.ImageInputHeight,
+		ImageChannelCount:  p.ImageChannelCount,
+		InputFeaturesCount: p.InputFeaturesCount,
+		Frequency:          p.Frequency,
+		Labels:             p.Labels,
+	}, nil
+}
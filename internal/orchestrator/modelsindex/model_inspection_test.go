@@ -0,0 +1,134 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package modelsindex
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+func TestInspect(t *testing.T) {
+	t.Run("stock model", func(t *testing.T) {
+		params := Inspect(AIModel{
+			ID:          "mobilenet",
+			ModelLabels: []string{"cat", "dog"},
+			Metadata:    map[string]string{"ei-gpu-mode": "true"},
+		})
+		require.Equal(t, ModelParameters{Labels: []string{"cat", "dog"}, GPUAcceleration: true}, params)
+	})
+
+	t.Run("custom model with parameters", func(t *testing.T) {
+		dir := paths.New(t.TempDir())
+		require.NoError(t, dir.Join("cats.eim").WriteFile([]byte("eim")))
+		require.NoError(t, dir.Join("cats.json").WriteFile([]byte(`{
+  "name": "Cats",
+  "bricks": ["arduino:object_detection"],
+  "model_parameters": {
+    "sensor": "camera",
+    "model_type": "object_detection",
+    "image_input_width": 320,
+    "image_input_height": 240,
+    "image_channel_count": 3,
+    "labels": ["cat"]
+  }
+}`)))
+		model, err := LoadCustomModel(dir.Join("cats.json"))
+		require.NoError(t, err)
+		require.Equal(t, ModelParameters{
+			Sensor:            SensorCamera,
+			ModelType:         "object_detection",
+			ImageInputWidth:   320,
+			ImageInputHeight:  240,
+			ImageChannelCount: 3,
+			Labels:            []string{"cat"},
+		}, Inspect(*model))
+	})
+}
+
+func TestCheckBrickCompatibility(t *testing.T) {
+	objectDetection := &bricksindex.Brick{ID: "arduino:object_detection", RequireModel: true, Category: "video", ModelType: "object_detection"}
+	keywordSpotting := &bricksindex.Brick{ID: "arduino:keyword_spotting", RequireModel: true, RequiredDevices: []string{"microphone"}}
+
+	camera := AIModel{
+		ID:         "cats",
+		Bricks:     []string{"arduino:object_detection", "arduino:keyword_spotting"},
+		Parameters: &ModelParameters{Sensor: SensorCamera, ModelType: "object_detection"},
+	}
+	require.NoError(t, CheckBrickCompatibility(camera, objectDetection))
+	require.ErrorIs(t, CheckBrickCompatibility(camera, keywordSpotting), ErrIncompatibleModel)
+
+	classification := AIModel{
+		ID:         "mobilenet",
+		Bricks:     []string{"arduino:object_detection"},
+		Parameters: &ModelParameters{Sensor: SensorCamera, ModelType: "classification"},
+	}
+	require.ErrorIs(t, CheckBrickCompatibility(classification, objectDetection), ErrIncompatibleModel)
+
+	notDeclared := AIModel{ID: "other", Bricks: []string{"arduino:image_classification"}}
+	require.ErrorIs(t, CheckBrickCompatibility(notDeclared, objectDetection), ErrIncompatibleModel)
+
+	// Models with unknown parameters are accepted.
+	unknown := AIModel{ID: "unknown", Bricks: []string{"arduino:keyword_spotting"}}
+	require.NoError(t, CheckBrickCompatibility(unknown, keywordSpotting))
+}
+
+func TestReadEIMParameters(t *testing.T) {
+	socket := paths.New(t.TempDir(), "runner.sock")
+	listener, err := net.Listen("unix", socket.String())
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var hello map[string]int
+		if err := json.NewDecoder(conn).Decode(&hello); err != nil || hello["hello"] != 1 {
+			return
+		}
+		_, _ = conn.Write([]byte(`{"success":true,"model_parameters":{"sensor":3,"model_type":"object_detection","image_input_width":320,"image_input_height":320,"image_channel_count":3,"labels":["cat"]}}`))
+	}()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	params, err := ReadEIMParameters(ctx, socket)
+	require.NoError(t, err)
+	require.Equal(t, &ModelParameters{
+		Sensor:            SensorCamera,
+		ModelType:         "object_detection",
+		ImageInputWidth:   320,
+		ImageInputHeight:  320,
+		ImageChannelCount: 3,
+		Labels:            []string{"cat"},
+	}, params)
+
+	t.Run("model not running", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+		defer cancel()
+		_, err := ReadEIMParameters(ctx, paths.New(t.TempDir(), "missing.sock"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
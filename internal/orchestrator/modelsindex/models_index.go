@@ -16,12 +16,20 @@
 package modelsindex
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"slices"
+	"strings"
+	"sync"
 
 	"github.com/arduino/go-paths-helper"
 	"github.com/goccy/go-yaml"
 )
 
+var ErrModelAlreadyExists = errors.New("model already exists")
+
 type assetsModelList struct {
 	Models []map[string]AIModel `yaml:"models"`
 }
@@ -51,25 +59,75 @@ type AIModel struct {
 	ModelLabels        []string          `yaml:"model_labels,omitempty"`
 	Metadata           map[string]string `yaml:"metadata,omitempty"`
 	ModelConfiguration map[string]string `yaml:"model_configuration,omitempty"`
+
+	// File is the .eim file of a custom model, it is nil for the models shipped with the assets.
+	File *paths.Path `yaml:"-"`
+	// Parameters are read from the sidecar file of a custom model, if available.
+	Parameters *ModelParameters `yaml:"-"`
+}
+
+func (m AIModel) IsCustom() bool {
+	return m.File != nil
 }
 
+// CustomModelMetadata is the content of the JSON sidecar file stored next to the .eim file
+// of a custom model.
+type CustomModelMetadata struct {
+	Name               string            `json:"name"`
+	Description        string            `json:"description,omitempty"`
+	Bricks             []string          `json:"bricks"`
+	Labels             []string          `json:"labels,omitempty"`
+	ModelConfiguration map[string]string `json:"model_configuration,omitempty"`
+	ModelParameters    *ModelParameters  `json:"model_parameters,omitempty"`
+}
+
+// ModelsIndex is safe for concurrent use, since custom models can be added and removed
+// while the index is in use.
 type ModelsIndex struct {
+	mu     sync.RWMutex
 	models []AIModel
 }
 
 func (m *ModelsIndex) GetModels() []AIModel {
-	return m.models
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.models)
 }
 
 func (m *ModelsIndex) GetModelByID(id string) (*AIModel, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	idx := slices.IndexFunc(m.models, func(v AIModel) bool { return v.ID == id })
 	if idx == -1 {
 		return nil, false
 	}
-	return &m.models[idx], true
+	model := m.models[idx]
+	return &model, true
+}
+
+// AddModel adds a model to the index, failing if a model with the same ID already exists.
+func (m *ModelsIndex) AddModel(model AIModel) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if slices.ContainsFunc(m.models, func(v AIModel) bool { return v.ID == model.ID }) {
+		return fmt.Errorf("%w: %s", ErrModelAlreadyExists, model.ID)
+	}
+	m.models = append(m.models, model)
+	return nil
+}
+
+// RemoveModel removes a model from the index, it returns false if the model does not exist.
+func (m *ModelsIndex) RemoveModel(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := len(m.models)
+	m.models = slices.DeleteFunc(m.models, func(v AIModel) bool { return v.ID == id })
+	return len(m.models) != n
 }
 
 func (m *ModelsIndex) GetModelsByBrick(brick string) []AIModel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	var matches []AIModel
 	for i := range m.models {
 		if len(m.models[i].Bricks) > 0 && slices.Contains(m.models[i].Bricks, brick) {
@@ -83,6 +141,8 @@ func (m *ModelsIndex) GetModelsByBrick(brick string) []AIModel {
 }
 
 func (m *ModelsIndex) GetModelsByBricks(bricks []string) []AIModel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	var matchingModels []AIModel
 	for _, model := range m.models {
 		for _, modelBrick := range model.Bricks {
@@ -115,3 +175,55 @@ func GenerateModelsIndexFromFile(dir *paths.Path) (*ModelsIndex, error) {
 	}
 	return &ModelsIndex{models: models}, nil
 }
+
+// AddCustomModels adds to the index the custom models stored in dir. Each model is made of
+// a <id>.eim file and of a <id>.json sidecar file with its metadata. Invalid models are skipped.
+func (m *ModelsIndex) AddCustomModels(dir *paths.Path) error {
+	if dir == nil || dir.NotExist() {
+		return nil
+	}
+	files, err := dir.ReadDir(paths.FilterOutDirectories(), paths.FilterSuffixes(".json"))
+	if err != nil {
+		return fmt.Errorf("cannot read custom models directory %q: %w", dir, err)
+	}
+	for _, file := range files {
+		model, err := LoadCustomModel(file)
+		if err != nil {
+			slog.Warn("invalid custom model, skipping", slog.String("path", file.String()), slog.String("error", err.Error()))
+			continue
+		}
+		if err := m.AddModel(*model); err != nil {
+			slog.Warn("custom model skipped", slog.String("path", file.String()), slog.String("error", err.Error()))
+		}
+	}
+	return nil
+}
+
+// LoadCustomModel reads the sidecar file of a custom model.
+func LoadCustomModel(sidecar *paths.Path) (*AIModel, error) {
+	content, err := sidecar.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var metadata CustomModelMetadata
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return nil, fmt.Errorf("cannot parse model metadata: %w", err)
+	}
+	id := strings.TrimSuffix(sidecar.Base(), sidecar.Ext())
+	file := sidecar.Parent().Join(id + ".eim")
+	if file.NotExist() {
+		return nil, fmt.Errorf("model file %s not found", file)
+	}
+	return &AIModel{
+		ID:                 id,
+		Name:               metadata.Name,
+		ModuleDescription:  metadata.Description,
+		Runner:             "brick",
+		Bricks:             metadata.Bricks,
+		ModelLabels:        metadata.Labels,
+		Metadata:           map[string]string{"source": "custom"},
+		ModelConfiguration: metadata.ModelConfiguration,
+		File:               file,
+		Parameters:         metadata.ModelParameters,
+	}, nil
+}
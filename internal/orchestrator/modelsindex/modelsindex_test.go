@@ -70,3 +70,53 @@ func TestModelsIndex(t *testing.T) {
 		assert.Equal(t, "yolox-object-detection", models[1].ID)
 	})
 }
+
+func TestCustomModels(t *testing.T) {
+	modelsIndex, err := GenerateModelsIndexFromFile(paths.New("testdata"))
+	require.NoError(t, err)
+
+	dir := paths.New(t.TempDir())
+	require.NoError(t, dir.Join("my-model.eim").WriteFile([]byte("eim")))
+	require.NoError(t, dir.Join("my-model.json").WriteFile([]byte(`{
+  "name": "My model",
+  "description": "A custom model",
+  "bricks": ["arduino:object_detection"],
+  "labels": ["cat", "dog"],
+  "model_configuration": {"EI_OBJ_DETECTION_MODEL": "/models/custom/my-model.eim"}
+}`)))
+	// A sidecar without the model file is skipped.
+	require.NoError(t, dir.Join("orphan.json").WriteFile([]byte(`{"name": "Orphan"}`)))
+	// A model with the same id of a shipped model is skipped.
+	require.NoError(t, dir.Join("face-detection.eim").WriteFile([]byte("eim")))
+	require.NoError(t, dir.Join("face-detection.json").WriteFile([]byte(`{"name": "Fake"}`)))
+
+	require.NoError(t, modelsIndex.AddCustomModels(dir))
+	require.Len(t, modelsIndex.GetModels(), 3)
+
+	model, found := modelsIndex.GetModelByID("my-model")
+	require.True(t, found)
+	assert.True(t, model.IsCustom())
+	assert.Equal(t, "My model", model.Name)
+	assert.Equal(t, "A custom model", model.ModuleDescription)
+	assert.Equal(t, []string{"cat", "dog"}, model.ModelLabels)
+	assert.Equal(t, "custom", model.Metadata["source"])
+	assert.Equal(t, dir.Join("my-model.eim"), model.File)
+	assert.Len(t, modelsIndex.GetModelsByBrick("arduino:object_detection"), 2)
+
+	model, found = modelsIndex.GetModelByID("face-detection")
+	require.True(t, found)
+	assert.False(t, model.IsCustom())
+	assert.Equal(t, "Lightweight-Face-Detection", model.Name)
+
+	require.ErrorIs(t, modelsIndex.AddModel(AIModel{ID: "my-model"}), ErrModelAlreadyExists)
+
+	assert.True(t, modelsIndex.RemoveModel("my-model"))
+	assert.False(t, modelsIndex.RemoveModel("my-model"))
+	_, found = modelsIndex.GetModelByID("my-model")
+	assert.False(t, found)
+	assert.Len(t, modelsIndex.GetModels(), 2)
+
+	t.Run("a missing directory is ignored", func(t *testing.T) {
+		require.NoError(t, modelsIndex.AddCustomModels(paths.New("nonexistentdir")))
+	})
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/secrets"
 	"github.com/arduino/arduino-app-cli/internal/store"
 )
 
@@ -72,16 +73,18 @@ type AppStreamMessage struct {
 type MessageType string
 
 const (
-	UnknownType  MessageType = ""
-	ProgressType MessageType = "progress"
-	InfoType     MessageType = "info"
-	ErrorType    MessageType = "error"
+	UnknownType    MessageType = ""
+	ProgressType   MessageType = "progress"
+	InfoType       MessageType = "info"
+	ErrorType      MessageType = "error"
+	DiagnosticType MessageType = "diagnostic"
 )
 
 type StreamMessage struct {
-	data     string
-	error    error
-	progress *Progress
+	data       string
+	error      error
+	progress   *Progress
+	diagnostic *SketchDiagnostic
 }
 
 type Progress struct {
@@ -89,12 +92,14 @@ type Progress struct {
 	Progress float32
 }
 
-func (p *StreamMessage) IsData() bool           { return p.data != "" }
-func (p *StreamMessage) IsError() bool          { return p.error != nil }
-func (p *StreamMessage) IsProgress() bool       { return p.progress != nil }
-func (p *StreamMessage) GetData() string        { return p.data }
-func (p *StreamMessage) GetError() error        { return p.error }
-func (p *StreamMessage) GetProgress() *Progress { return p.progress }
+func (p *StreamMessage) IsData() bool                     { return p.data != "" }
+func (p *StreamMessage) IsError() bool                    { return p.error != nil }
+func (p *StreamMessage) IsProgress() bool                 { return p.progress != nil }
+func (p *StreamMessage) IsDiagnostic() bool               { return p.diagnostic != nil }
+func (p *StreamMessage) GetData() string                  { return p.data }
+func (p *StreamMessage) GetError() error                  { return p.error }
+func (p *StreamMessage) GetProgress() *Progress           { return p.progress }
+func (p *StreamMessage) GetDiagnostic() *SketchDiagnostic { return p.diagnostic }
 func (p *StreamMessage) GetType() MessageType {
 	if p.IsData() {
 		return InfoType
@@ -105,9 +110,15 @@ func (p *StreamMessage) GetType() MessageType {
 	if p.IsProgress() {
 		return ProgressType
 	}
+	if p.IsDiagnostic() {
+		return DiagnosticType
+	}
 	return UnknownType
 }
 
+// StartApp starts the app, uploading its sketch to the micro. Unless force is set, it fails
+// with a FlashOwnedError if the upload would overwrite the sketch of another app stored
+// in the flash of the micro.
 func StartApp(
 	ctx context.Context,
 	docker command.Cli,
@@ -117,23 +128,27 @@ func StartApp(
 	app app.ArduinoApp,
 	cfg config.Configuration,
 	staticStore *store.StaticStore,
+	force bool,
 ) iter.Seq[StreamMessage] {
-	return func(yield func(StreamMessage) bool) {
+	return recordAppRun(app, AppRunStart, func(yield func(StreamMessage) bool) {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
-		running, err := getRunningApp(ctx, docker.Client())
+		running, err := getRunningApps(ctx, docker.Client(), cfg)
 		if err != nil {
 			yield(StreamMessage{error: err})
 			return
 		}
-		if running != nil {
-			yield(StreamMessage{error: fmt.Errorf("app %q is running", running.Name)})
+		if err := checkAppConflicts(app, running, bricksIndex, staticStore, cfg); err != nil {
+			yield(StreamMessage{error: err})
 			return
 		}
 		if !yield(StreamMessage{data: fmt.Sprintf("Starting app %q", app.Name)}) {
 			return
 		}
+		if err := resetAppRestartState(&app); err != nil {
+			slog.Warn("unable to reset app restart state", slog.String("app", app.FullPath.String()), slog.String("error", err.Error()))
+		}
 
 		if err := setStatusLeds(LedTriggerNone); err != nil {
 			slog.Debug("unable to set status leds", slog.String("error", err.Error()))
@@ -152,17 +167,46 @@ func StartApp(
 			if !yield(StreamMessage{progress: &Progress{Name: "sketch compiling and uploading", Progress: 0.0}}) {
 				return
 			}
-			if err := compileUploadSketch(ctx, &app, sketchCallbackWriter); err != nil {
+			onDiagnostic := func(d SketchDiagnostic) {
+				if ctx.Err() != nil {
+					return
+				}
+				if !yield(StreamMessage{diagnostic: &d}) {
+					cancel()
+				}
+			}
+			onCacheHit := func() {
+				if ctx.Err() != nil {
+					return
+				}
+				if !yield(StreamMessage{progress: &Progress{Name: "sketch unchanged, using cached build", Progress: 5.0}}) {
+					cancel()
+				}
+			}
+			err := compileUploadSketch(ctx, &app, cfg, sketchCallbackWriter, sketchBuildOptions{onDiagnostic: onDiagnostic, onCacheHit: onCacheHit, force: force})
+			if err != nil {
 				yield(StreamMessage{error: err})
 				return
 			}
+			if err := saveSketchState(cfg, &app); err != nil {
+				slog.Warn("unable to save sketch state", slog.String("app", app.FullPath.String()), slog.String("error", err.Error()))
+			}
 			if !yield(StreamMessage{progress: &Progress{Name: "sketch updated", Progress: 10.0}}) {
 				return
 			}
 		}
 
 		if app.MainPythonFile != nil {
-			envs := getAppEnvironmentVariables(app, bricksIndex, modelsIndex)
+			appSecrets, err := secrets.NewStore(cfg.DataDir()).Values(app.FullPath.String())
+			if err != nil {
+				yield(StreamMessage{error: fmt.Errorf("unable to read app secrets: %w", err)})
+				return
+			}
+			envs, secretEnvs, err := getAppEnvironmentVariables(app, bricksIndex, modelsIndex, appSecrets)
+			if err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
 
 			if !yield(StreamMessage{data: "python provisioning"}) {
 				cancel()
@@ -223,7 +267,9 @@ func StartApp(
 			})
 
 			slog.Debug("starting app", slog.String("command", strings.Join(commands, " ")), slog.Any("envs", envs))
-			process, err := paths.NewProcess(envs.AsList(), commands...)
+			processEnvs := maps.Clone(envs)
+			maps.Copy(processEnvs, secretEnvs)
+			process, err := paths.NewProcess(processEnvs.AsList(), commands...)
 			if err != nil {
 				yield(StreamMessage{error: err})
 				return
@@ -241,7 +287,7 @@ func StartApp(
 			}
 		}
 		_ = yield(StreamMessage{progress: &Progress{Name: "", Progress: 100.0}})
-	}
+	})
 }
 
 // getAppEnvironmentVariables returns the environment variables for the app by merging variables and config in the following order:
@@ -249,8 +295,14 @@ func StartApp(
 // - model configuration variables (variables defined in the model configuration)
 // - brick instance variables (variables defined in the app.yaml for the brick instance)
 // In addition, it adds some useful environment variables like APP_HOME and HOST_IP.
-func getAppEnvironmentVariables(app app.ArduinoApp, brickIndex *bricksindex.BricksIndex, modelsIndex *modelsindex.ModelsIndex) helpers.EnvVars {
-	envs := make(helpers.EnvVars)
+//
+// Brick instance variables referencing secrets (${secret:<name>}) are resolved with appSecrets
+// and returned separately in secretEnvs. In envs they are replaced by a compose interpolation
+// of the variable itself, so the values never end up in the generated compose files: they must
+// be passed to the docker compose process environment.
+func getAppEnvironmentVariables(app app.ArduinoApp, brickIndex *bricksindex.BricksIndex, modelsIndex *modelsindex.ModelsIndex, appSecrets map[string]string) (envs helpers.EnvVars, secretEnvs helpers.EnvVars, err error) {
+	envs = make(helpers.EnvVars)
+	secretEnvs = make(helpers.EnvVars)
 
 	for _, brick := range app.Descriptor.Bricks {
 		if brickDef, found := brickIndex.FindBrickByID(brick.ID); found {
@@ -262,7 +314,19 @@ func getAppEnvironmentVariables(app app.ArduinoApp, brickIndex *bricksindex.Bric
 		}
 
 		slog.Debug("adding Brick", slog.String("brickID", brick.ID), slog.String("model", brick.Model), slog.Any("variables", brick.Variables))
-		maps.Insert(envs, maps.All(brick.Variables))
+		for name, value := range brick.Variables {
+			if len(secrets.References(value)) == 0 {
+				envs[name] = value
+				delete(secretEnvs, name)
+				continue
+			}
+			resolved, err := secrets.Resolve(value, appSecrets)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to resolve variable %q of brick %q: %w", name, brick.ID, err)
+			}
+			envs[name] = "${" + name + ":-}"
+			secretEnvs[name] = resolved
+		}
 	}
 
 	// Add the APP_HOME directory to the environment variables
@@ -283,7 +347,7 @@ func getAppEnvironmentVariables(app app.ArduinoApp, brickIndex *bricksindex.Bric
 
 	slog.Debug("Current environment variables", slog.Any("envs", envs))
 
-	return envs
+	return envs, secretEnvs, nil
 }
 
 func extractIndexFromVideoDeviceName(device string) (int, error) {
@@ -377,14 +441,17 @@ func getVideoDevices() map[int]string {
 	return deviceMap
 }
 
-func stopAppWithCmd(ctx context.Context, app app.ArduinoApp, cmd string) iter.Seq[StreamMessage] {
-	return func(yield func(StreamMessage) bool) {
+func stopAppWithCmd(ctx context.Context, app app.ArduinoApp, cfg config.Configuration, cmd string) iter.Seq[StreamMessage] {
+	return recordAppRun(app, AppRunStop, func(yield func(StreamMessage) bool) {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
 		if !yield(StreamMessage{data: fmt.Sprintf("Stopping app %q", app.Name)}) {
 			return
 		}
+		if err := markAppStopped(&app); err != nil {
+			slog.Warn("unable to update app restart state", slog.String("app", app.FullPath.String()), slog.String("error", err.Error()))
+		}
 		if err := setStatusLeds(LedTriggerDefault); err != nil {
 			slog.Debug("unable to set status leds", slog.String("error", err.Error()))
 		}
@@ -396,11 +463,19 @@ func stopAppWithCmd(ctx context.Context, app app.ArduinoApp, cmd string) iter.Se
 			}
 		})
 		if app.MainSketchPath != nil {
-			// TODO: check that the app sketch is running before attempting to stop it.
-
-			if err := micro.Disable(); err != nil {
-				yield(StreamMessage{error: err})
-				return
+			state, err := readSketchState(cfg)
+			if err != nil {
+				slog.Warn("unable to read sketch state", slog.String("error", err.Error()))
+			}
+			// Do not stop the sketch of another app. If the loaded sketch is unknown, the micro is disabled anyway.
+			if state == nil || state.AppPath == app.FullPath.String() {
+				if err := micro.Disable(); err != nil {
+					yield(StreamMessage{error: err})
+					return
+				}
+				if err := clearSketchState(cfg); err != nil {
+					slog.Warn("unable to clear sketch state", slog.String("error", err.Error()))
+				}
 			}
 		}
 
@@ -422,15 +497,15 @@ func stopAppWithCmd(ctx context.Context, app app.ArduinoApp, cmd string) iter.Se
 			}
 		}
 		_ = yield(StreamMessage{progress: &Progress{Name: "", Progress: 100.0}})
-	}
+	})
 }
 
-func StopApp(ctx context.Context, app app.ArduinoApp) iter.Seq[StreamMessage] {
-	return stopAppWithCmd(ctx, app, "stop")
+func StopApp(ctx context.Context, app app.ArduinoApp, cfg config.Configuration) iter.Seq[StreamMessage] {
+	return stopAppWithCmd(ctx, app, cfg, "stop")
 }
 
-func StopAndDestroyApp(ctx context.Context, app app.ArduinoApp) iter.Seq[StreamMessage] {
-	return stopAppWithCmd(ctx, app, "down")
+func StopAndDestroyApp(ctx context.Context, app app.ArduinoApp, cfg config.Configuration) iter.Seq[StreamMessage] {
+	return stopAppWithCmd(ctx, app, cfg, "down")
 }
 
 func RestartApp(
@@ -446,19 +521,22 @@ func RestartApp(
 	return func(yield func(StreamMessage) bool) {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
-		runningApp, err := getRunningApp(ctx, docker.Client())
+		runningApps, err := getRunningApps(ctx, docker.Client(), cfg)
 		if err != nil {
 			yield(StreamMessage{error: err})
 			return
 		}
 
-		if runningApp != nil {
-			if runningApp.FullPath.String() != appToStart.FullPath.String() {
+		for _, runningApp := range runningApps {
+			if !runningApp.FullPath.EqualsTo(appToStart.FullPath) {
+				if cfg.AllowMultipleApps {
+					continue
+				}
 				yield(StreamMessage{error: fmt.Errorf("another app %q is running", runningApp.Name)})
 				return
 			}
 
-			stopStream := StopApp(ctx, *runningApp)
+			stopStream := StopApp(ctx, runningApp, cfg)
 			for msg := range stopStream {
 				if !yield(msg) {
 					return
@@ -468,7 +546,7 @@ func RestartApp(
 				}
 			}
 		}
-		startStream := StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, appToStart, cfg, staticStore)
+		startStream := StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, appToStart, cfg, staticStore, false)
 		startStream(yield)
 	}
 }
@@ -501,7 +579,7 @@ func StartDefaultApp(
 	}
 
 	// TODO: we need to stop all other running app before starting the default app.
-	for msg := range StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, *app, cfg, staticStore) {
+	for msg := range StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, *app, cfg, staticStore, false) {
 		if msg.IsError() {
 			return fmt.Errorf("failed to start app: %w", msg.GetError())
 		}
@@ -554,7 +632,7 @@ func ListApps(
 		appPaths       paths.PathList
 	)
 
-	apps, err := getAppsStatus(ctx, docker.Client())
+	apps, err := getAppsStatus(ctx, docker.Client(), cfg)
 	if err != nil {
 		slog.Error("unable to get running app", slog.String("error", err.Error()))
 	}
@@ -653,6 +731,8 @@ type AppDetailedInfo struct {
 	Example     bool               `json:"example"`
 	Default     bool               `json:"default"`
 	Bricks      []AppDetailedBrick `json:"bricks,omitempty"`
+	Restarts    *AppRestartInfo    `json:"restarts,omitempty"`
+	Sketch      *AppSketchInfo     `json:"sketch,omitempty"`
 }
 
 type AppDetailedBrick struct {
@@ -675,7 +755,7 @@ func AppDetails(
 	var status Status
 	go func() {
 		defer wg.Done()
-		app, err := getAppStatus(ctx, docker, userApp)
+		app, err := getAppStatus(ctx, docker, userApp, cfg)
 		if err != nil {
 			slog.Warn("unable to get app status", slog.String("error", err.Error()), slog.String("path", userApp.FullPath.String()))
 			status = StatusStopped
@@ -723,6 +803,8 @@ func AppDetails(
 			res.Category = bi.Category
 			return res
 		}),
+		Restarts: getAppRestartInfo(&userApp),
+		Sketch:   getAppSketchInfo(cfg, &userApp),
 	}, nil
 }
 
@@ -888,12 +970,15 @@ func CloneApp(
 	return CloneAppResponse{ID: id}, nil
 }
 
-func DeleteApp(ctx context.Context, app app.ArduinoApp) error {
-	for msg := range StopApp(ctx, app) {
+func DeleteApp(ctx context.Context, app app.ArduinoApp, cfg config.Configuration) error {
+	for msg := range StopApp(ctx, app, cfg) {
 		if msg.error != nil {
 			return fmt.Errorf("failed to stop app: %w", msg.error)
 		}
 	}
+	if err := secrets.NewStore(cfg.DataDir()).DeleteAll(app.FullPath.String()); err != nil {
+		return fmt.Errorf("failed to delete app secrets: %w", err)
+	}
 	return app.FullPath.RemoveAll()
 }
 
@@ -971,6 +1056,9 @@ func EditApp(
 			editErr = fmt.Errorf("failed to rename app path: %w", err)
 			return editErr
 		}
+		if err := secrets.NewStore(cfg.DataDir()).Move(editApp.FullPath.String(), newPath.String()); err != nil {
+			return fmt.Errorf("failed to move app secrets: %w", err)
+		}
 		editApp.FullPath = newPath
 		editApp.Name = editApp.Descriptor.Name
 	}
@@ -1119,11 +1207,60 @@ func addLedControl(volumes []volume) []volume {
 	return volumes
 }
 
+type sketchBuildOptions struct {
+	// onDiagnostic is called with each problem reported by the compiler.
+	onDiagnostic func(SketchDiagnostic)
+	// onCacheHit is called when the compilation is skipped because the sketch
+	// didn't change since the last build.
+	onCacheHit func()
+	// flash burns the sketch in the flash of the micro even if the app deploys it in RAM.
+	flash bool
+	// force overwrites the sketch of another app stored in the flash of the micro.
+	force  bool
+	upload bool
+}
+
+// compileUploadSketch builds the sketch of the app and uploads it to the micro,
+// according to the deploy mode of the app.
 func compileUploadSketch(
 	ctx context.Context,
 	arduinoApp *app.ArduinoApp,
+	cfg config.Configuration,
 	w io.Writer,
+	opts sketchBuildOptions,
 ) error {
+	opts.upload = true
+	return buildSketch(ctx, arduinoApp, cfg, w, opts)
+}
+
+// FQBNs of the micro, the sketch is built with the same flash mode used to upload it.
+const (
+	sketchFQBNRam   = "arduino:zephyr:unoq:flash_mode=ram"
+	sketchFQBNFlash = "arduino:zephyr:unoq:flash_mode=flash"
+)
+
+func sketchFQBN(flash bool) string {
+	if flash {
+		return sketchFQBNFlash
+	}
+	return sketchFQBNRam
+}
+
+// buildSketch compiles the sketch of the app and, if requested, uploads it to the micro.
+// The sketch is compiled for the flash mode it is uploaded with. Before an upload the
+// compilation is skipped if the last build of the sketch is still valid.
+func buildSketch(
+	ctx context.Context,
+	arduinoApp *app.ArduinoApp,
+	cfg config.Configuration,
+	w io.Writer,
+	opts sketchBuildOptions,
+) error {
+	if opts.upload {
+		if err := checkFlashOwner(cfg, arduinoApp, opts.force); err != nil {
+			return err
+		}
+	}
 	logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
 	srv := commands.NewArduinoCoreServer()
 
@@ -1185,25 +1322,42 @@ func compileUploadSketch(
 		return err
 	}
 
+	opts.flash = opts.flash || arduinoApp.Descriptor.GetSketchDeployMode() == app.SketchDeployFlash
+	fqbn := sketchFQBN(opts.flash)
+	if opts.upload && isSketchBuildCached(arduinoApp, profile, fqbn, installedPlatformVersion(ctx, srv, inst, fqbn)) {
+		slog.Info("sketch unchanged, skipping compilation", slog.String("app", arduinoApp.FullPath.String()))
+		if opts.onCacheHit != nil {
+			opts.onCacheHit()
+		}
+		return uploadSketch(ctx, w, srv, inst, arduinoApp, cfg, opts.flash)
+	}
+
 	// build the sketch
 	server, getCompileResult := commands.CompilerServerToStreams(ctx, w, w, nil)
 	compileReq := rpc.CompileRequest{
 		Instance:   inst,
-		Fqbn:       "arduino:zephyr:unoq",
+		Fqbn:       fqbn,
 		SketchPath: sketchPath,
 		BuildPath:  buildPath,
 		Jobs:       2,
 	}
 
 	err = srv.Compile(&compileReq, server)
+	result := getCompileResult()
+	if opts.onDiagnostic != nil && result != nil {
+		for _, d := range parseCompileDiagnostics(arduinoApp, result.GetDiagnostics()) {
+			opts.onDiagnostic(d)
+		}
+	}
 	if err != nil {
+		if err := clearSketchBuildCache(arduinoApp); err != nil {
+			slog.Warn("unable to clear sketch build cache", slog.String("error", err.Error()))
+		}
 		return err
 	}
 
 	// Output compilations details
-	result := getCompileResult()
 	f.Assert(result != nil, "Failed to get compilation result")
-	// TODO: maybe handle result.GetDiagnostics()
 	boardPlatform := result.GetBoardPlatform()
 	if boardPlatform != nil {
 		slog.Info("Board platform: " + boardPlatform.GetId() + " (" + boardPlatform.GetVersion() + ") in " + boardPlatform.GetInstallDir())
@@ -1212,20 +1366,92 @@ func compileUploadSketch(
 	if buildPlatform != nil && buildPlatform.GetInstallDir() != boardPlatform.GetInstallDir() {
 		slog.Info("Build platform: " + buildPlatform.GetId() + " (" + buildPlatform.GetVersion() + ") in " + buildPlatform.GetInstallDir())
 	}
+	libraries := []string{}
 	for _, lib := range result.GetUsedLibraries() {
 		slog.Info("Used library " + lib.GetName() + " (" + lib.GetVersion() + ") in " + lib.GetInstallDir())
+		libraries = append(libraries, lib.GetInstallDir())
+	}
+	platform := sketchBuildPlatform{
+		ID:         boardPlatform.GetId(),
+		Version:    boardPlatform.GetVersion(),
+		InstallDir: boardPlatform.GetInstallDir(),
+	}
+	if err := saveSketchBuildCache(arduinoApp, profile, fqbn, platform, libraries); err != nil {
+		slog.Warn("unable to save sketch build cache", slog.String("error", err.Error()))
+	}
+	if !opts.upload {
+		return nil
+	}
+	return uploadSketch(ctx, w, srv, inst, arduinoApp, cfg, opts.flash)
+}
+
+// installedPlatformVersion returns the version of the platform of the board, empty if unknown.
+func installedPlatformVersion(ctx context.Context, srv rpc.ArduinoCoreServiceServer, inst *rpc.Instance, fqbn string) string {
+	resp, err := srv.BoardDetails(ctx, &rpc.BoardDetailsRequest{Instance: inst, Fqbn: fqbn})
+	if err != nil {
+		slog.Debug("unable to read the board platform", slog.String("error", err.Error()))
+		return ""
+	}
+	return resp.GetVersion()
+}
+
+// uploadSketch uploads the built sketch of the app in the RAM or in the flash of the micro,
+// keeping track of the app owning the flash contents.
+func uploadSketch(ctx context.Context,
+	w io.Writer,
+	srv rpc.ArduinoCoreServiceServer,
+	inst *rpc.Instance,
+	arduinoApp *app.ArduinoApp,
+	cfg config.Configuration,
+	flash bool,
+) error {
+	sketchPath := arduinoApp.MainSketchPath.String()
+	buildPath := arduinoApp.SketchBuildPath().String()
+
+	flashState, err := readFlashState(cfg)
+	if err != nil {
+		slog.Warn("unable to read flash state", slog.String("error", err.Error()))
+	}
+	warnFlashOverwrite := func() {
+		if flashState == nil || flashState.AppPath == arduinoApp.FullPath.String() {
+			return
+		}
+		fmt.Fprintf(w, "Warning: the sketch of the app %q stored in the flash of the micro will be overwritten\n", flashOwnerName(flashState))
+	}
+
+	if flash {
+		warnFlashOverwrite()
+		if err := uploadSketchInFlash(ctx, w, srv, inst, sketchPath, buildPath); err != nil {
+			return err
+		}
+		if err := saveFlashState(cfg, arduinoApp); err != nil {
+			slog.Warn("unable to save flash state", slog.String("error", err.Error()))
+		}
+		return nil
 	}
 
 	if err := uploadSketchInRam(ctx, w, srv, inst, sketchPath, buildPath); err != nil {
 		slog.Warn("failed to upload in ram mode, trying to configure the board in ram mode, and retry", slog.String("error", err.Error()))
+		warnFlashOverwrite()
 		if err := configureMicroInRamMode(ctx, w, srv, inst); err != nil {
 			return err
 		}
+		if err := clearFlashState(cfg); err != nil {
+			slog.Warn("unable to clear flash state", slog.String("error", err.Error()))
+		}
 		return uploadSketchInRam(ctx, w, srv, inst, sketchPath, buildPath)
 	}
 	return nil
 }
 
+// flashOwnerName returns the name of the app owning the flash contents.
+func flashOwnerName(state *sketchState) string {
+	if a, err := app.Load(state.AppPath); err == nil {
+		return a.Name
+	}
+	return state.AppPath
+}
+
 func uploadSketchInRam(ctx context.Context,
 	w io.Writer,
 	srv rpc.ArduinoCoreServiceServer,
@@ -1236,7 +1462,7 @@ func uploadSketchInRam(ctx context.Context,
 	stream, _ := commands.UploadToServerStreams(ctx, w, w)
 	if err := srv.Upload(&rpc.UploadRequest{
 		Instance:   inst,
-		Fqbn:       "arduino:zephyr:unoq:flash_mode=ram",
+		Fqbn:       sketchFQBNRam,
 		SketchPath: sketchPath,
 		ImportDir:  buildPath,
 	}, stream); err != nil {
@@ -1245,6 +1471,22 @@ func uploadSketchInRam(ctx context.Context,
 	return nil
 }
 
+func uploadSketchInFlash(ctx context.Context,
+	w io.Writer,
+	srv rpc.ArduinoCoreServiceServer,
+	inst *rpc.Instance,
+	sketchPath string,
+	buildPath string,
+) error {
+	stream, _ := commands.UploadToServerStreams(ctx, w, w)
+	return srv.Upload(&rpc.UploadRequest{
+		Instance:   inst,
+		Fqbn:       sketchFQBNFlash,
+		SketchPath: sketchPath,
+		ImportDir:  buildPath,
+	}, stream)
+}
+
 // configureMicroInRamMode uploads an empty binary overing any sketch previously uploaded in flash.
 // This is required to be able to upload sketches in ram mode after if there is already a sketch in flash.
 func configureMicroInRamMode(
@@ -1275,13 +1517,15 @@ func configureMicroInRamMode(
 	stream, _ := commands.UploadToServerStreams(ctx, w, w)
 	return srv.Upload(&rpc.UploadRequest{
 		Instance:  inst,
-		Fqbn:      "arduino:zephyr:unoq:flash_mode=flash",
+		Fqbn:      sketchFQBNFlash,
 		ImportDir: emptyBinDir.String(),
 	}, stream)
 }
 
 type ConfigResponse struct {
 	Directories ConfigDirectories `json:"directories"`
+	// Settings are the effective settings, after applying the configuration files and the environment.
+	Settings config.Settings `json:"settings"`
 }
 
 type ConfigDirectories struct {
@@ -1297,5 +1541,6 @@ func GetOrchestratorConfig(cfg config.Configuration) ConfigResponse {
 			Apps:     cfg.AppsDir().String(),
 			Examples: cfg.ExamplesDir().String(),
 		},
+		Settings: cfg.Settings,
 	}
 }
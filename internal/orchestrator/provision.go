@@ -24,9 +24,11 @@ import (
 	"os"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/arduino/go-paths-helper"
+	"github.com/compose-spec/compose-go/v2/template"
 	"github.com/containerd/errdefs"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/docker/api/types/container"
@@ -34,6 +36,7 @@ import (
 
 	"github.com/arduino/arduino-app-cli/internal/helpers"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/store"
@@ -74,8 +77,10 @@ type Provision struct {
 	pythonImage string
 }
 
+// isDevelopmentMode reports if a runner different from the installed one is used. The
+// assets pinned by the user are never considered in development.
 func isDevelopmentMode(cfg config.Configuration) bool {
-	return cfg.RunnerVersion != cfg.UsedPythonImageTag
+	return cfg.PinnedAssetsVersion == "" && cfg.RunnerVersion != cfg.UsedPythonImageTag
 }
 
 func NewProvision(
@@ -105,6 +110,9 @@ func NewProvision(
 	if err := provision.init(tmpProvisionDir.String()); err != nil {
 		return nil, fmt.Errorf("failed to perform dynamic provisioning: %w", err)
 	}
+	if err := assets.WriteProvisionInfo(tmpProvisionDir, cfg.PythonImage); err != nil {
+		slog.Warn("unable to save the provisioning info", slog.String("error", err.Error()))
+	}
 	if err := tmpProvisionDir.Rename(dynamicProvisionDir); err != nil {
 		return nil, fmt.Errorf("failed to rename tmp provisioning folder: %w", err)
 	}
@@ -215,6 +223,10 @@ func generateMainComposeFile(
 ) error {
 	slog.Debug("Generating main compose file for the App")
 
+	if err := checkAppBricks(app, bricksIndex, staticStore, envs); err != nil {
+		return err
+	}
+
 	ports := make(map[string]struct{}, len(app.Descriptor.Ports))
 	for _, p := range app.Descriptor.Ports {
 		ports[fmt.Sprintf("%d:%d", p, p)] = struct{}{}
@@ -376,8 +388,8 @@ func generateMainComposeFile(
 			Logging: &logging{
 				Driver: "json-file",
 				Options: map[string]string{
-					"max-size": "5m",
-					"max-file": "2",
+					"max-size": cfg.Logs.MaxSize,
+					"max-file": strconv.Itoa(cfg.Logs.MaxFiles),
 				},
 			},
 		},
@@ -394,7 +406,7 @@ func generateMainComposeFile(
 
 	// If there are services that require devices, we need to generate an override compose file
 	// Write additional file to override devices section in included compose files
-	if e := generateServicesOverrideFile(app, slices.Collect(maps.Keys(services)), servicesThatRequireDevices, devices.devicePaths, getCurrentUser(), groups, overrideComposeFile, envs); e != nil {
+	if e := generateServicesOverrideFile(app, services, cfg.Images, servicesThatRequireDevices, devices.devicePaths, getCurrentUser(), groups, overrideComposeFile, envs); e != nil {
 		return e
 	}
 
@@ -419,6 +431,8 @@ func generateMainComposeFile(
 
 type serviceInfo struct {
 	hasHealthcheck bool
+	ports          []string
+	image          string
 }
 
 func extractServicesFromComposeFile(composeFile *paths.Path) (map[string]serviceInfo, error) {
@@ -428,7 +442,8 @@ func extractServicesFromComposeFile(composeFile *paths.Path) (map[string]service
 	}
 
 	type serviceMin struct {
-		Image       string `yaml:"image"`
+		Image       string   `yaml:"image"`
+		Ports       []string `yaml:"ports,omitempty"`
 		Healthcheck struct {
 			Test []string `yaml:"test"`
 		} `yaml:"healthcheck,omitempty"`
@@ -443,12 +458,17 @@ func extractServicesFromComposeFile(composeFile *paths.Path) (map[string]service
 	services := make(map[string]serviceInfo, len(index.Services))
 	for svc, svcDef := range index.Services {
 		hasHealthcheck := len(svcDef.Healthcheck.Test) > 0
-		services[svc] = serviceInfo{hasHealthcheck: hasHealthcheck}
+		// The image is interpolated as done by compose, the variables are read from the environment.
+		image, err := template.Substitute(svcDef.Image, os.LookupEnv)
+		if err != nil {
+			image = svcDef.Image
+		}
+		services[svc] = serviceInfo{hasHealthcheck: hasHealthcheck, ports: svcDef.Ports, image: image}
 	}
 	return services, nil
 }
 
-func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string, servicesThatRequireDevices []string, devices []string, user string, groups []string, overrideComposeFile *paths.Path, envs helpers.EnvVars) error {
+func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services map[string]serviceInfo, images config.ImagesConfig, servicesThatRequireDevices []string, devices []string, user string, groups []string, overrideComposeFile *paths.Path, envs helpers.EnvVars) error {
 	if overrideComposeFile.Exist() {
 		if err := overrideComposeFile.Remove(); err != nil {
 			return fmt.Errorf("failed to remove existing override compose file: %w", err)
@@ -461,6 +481,7 @@ func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string,
 	}
 
 	type serviceOverride struct {
+		Image       string            `yaml:"image,omitempty"`
 		User        string            `yaml:"user,omitempty"`
 		Devices     *[]string         `yaml:"devices,omitempty"`
 		GroupAdd    *[]string         `yaml:"group_add,omitempty"`
@@ -471,7 +492,7 @@ func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string,
 		Services map[string]serviceOverride `yaml:"services,omitempty"`
 	}
 	overrideCompose.Services = make(map[string]serviceOverride, len(services))
-	for _, svc := range services {
+	for svc, info := range services {
 		override := serviceOverride{
 			User: user,
 			Labels: map[string]string{
@@ -479,6 +500,10 @@ func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string,
 				DockerAppPathLabel: arduinoApp.FullPath.String(),
 			},
 		}
+		// The images of the bricks are replaced by the mirrored and pinned ones.
+		if resolved := images.Resolve(info.image); info.image != "" && resolved != info.image {
+			override.Image = resolved
+		}
 		if slices.Contains(servicesThatRequireDevices, svc) {
 			override.Devices = &devices
 			override.GroupAdd = &groups
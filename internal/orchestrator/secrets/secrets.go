@@ -0,0 +1,299 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package secrets implements the per-app secrets store.
+//
+// Secrets are kept outside of the app folder, in a single file of the data
+// directory encrypted with AES-256-GCM. The encryption key is generated on
+// first use and stored next to it, readable only by the owner.
+// An app references a secret from the variables of its bricks with the
+// ${secret:<name>} syntax, the value is resolved only when the app is started.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/google/renameio/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/arduino/arduino-app-cli/internal/filestore"
+)
+
+var (
+	ErrInvalidName    = errors.New("invalid secret name")
+	ErrSecretNotFound = errors.New("secret not found")
+)
+
+const (
+	storeFileName = "secrets.enc"
+	keyFileName   = "secrets.key"
+	keySize       = 32
+)
+
+// Store is the encrypted secrets store. Secrets are grouped by app, the app is
+// identified by the full path of its folder.
+type Store struct {
+	filePath string
+	keyPath  string
+}
+
+func NewStore(dataDir *paths.Path) *Store {
+	return &Store{
+		filePath: dataDir.Join(storeFileName).String(),
+		keyPath:  dataDir.Join(keyFileName).String(),
+	}
+}
+
+// Names returns the sorted names of the secrets of the app.
+func (s *Store) Names(app string) ([]string, error) {
+	values, err := s.Values(app)
+	if err != nil {
+		return nil, err
+	}
+	names := slices.Collect(maps.Keys(values))
+	slices.Sort(names)
+	return names, nil
+}
+
+// Values returns the secrets of the app, by name.
+func (s *Store) Values(app string) (map[string]string, error) {
+	unlock, err := filestore.ReadLock(s.filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer filestore.Release(s.filePath, unlock)
+
+	content, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	values := content[app]
+	if values == nil {
+		values = map[string]string{}
+	}
+	return values, nil
+}
+
+func (s *Store) Set(app, name, value string) error {
+	if err := filestore.ValidateKey(name); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidName, err)
+	}
+	return s.update(func(content map[string]map[string]string) bool {
+		if content[app] == nil {
+			content[app] = map[string]string{}
+		}
+		content[app][name] = value
+		return true
+	})
+}
+
+// Delete removes a secret of the app, it returns false if the secret was not found.
+func (s *Store) Delete(app, name string) (bool, error) {
+	if err := filestore.ValidateKey(name); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrInvalidName, err)
+	}
+	found := false
+	err := s.update(func(content map[string]map[string]string) bool {
+		if _, found = content[app][name]; !found {
+			return false
+		}
+		delete(content[app], name)
+		if len(content[app]) == 0 {
+			delete(content, app)
+		}
+		return true
+	})
+	return found, err
+}
+
+// DeleteAll removes all the secrets of the app.
+func (s *Store) DeleteAll(app string) error {
+	return s.update(func(content map[string]map[string]string) bool {
+		if _, found := content[app]; !found {
+			return false
+		}
+		delete(content, app)
+		return true
+	})
+}
+
+// Move reassigns the secrets of an app to a new app path, it is used when the
+// app folder is renamed.
+func (s *Store) Move(from, to string) error {
+	return s.update(func(content map[string]map[string]string) bool {
+		values, found := content[from]
+		if !found || from == to {
+			return false
+		}
+		delete(content, from)
+		content[to] = values
+		return true
+	})
+}
+
+func (s *Store) update(apply func(content map[string]map[string]string) bool) error {
+	unlock, err := filestore.WriteLock(s.filePath)
+	if err != nil {
+		return err
+	}
+	defer filestore.Release(s.filePath, unlock)
+
+	content, err := s.read()
+	if err != nil {
+		return err
+	}
+	if !apply(content) {
+		return nil
+	}
+	return s.write(content)
+}
+
+func (s *Store) read() (map[string]map[string]string, error) {
+	data, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[string]map[string]string), nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return make(map[string]map[string]string), nil
+	}
+
+	key, err := s.readKey()
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("secrets store %s is corrupted", s.filePath)
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt secrets store %s: %w", s.filePath, err)
+	}
+
+	var content map[string]map[string]string
+	if err := msgpack.Unmarshal(plaintext, &content); err != nil {
+		return nil, err
+	}
+	if content == nil {
+		content = make(map[string]map[string]string)
+	}
+	return content, nil
+}
+
+func (s *Store) write(content map[string]map[string]string) error {
+	plaintext, err := msgpack.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	key, err := s.readOrCreateKey()
+	if err != nil {
+		return err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	return renameio.WriteFile(s.filePath, gcm.Seal(nonce, nonce, plaintext, nil), 0600)
+}
+
+func (s *Store) readKey() ([]byte, error) {
+	key, err := os.ReadFile(s.keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secrets key: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("invalid secrets key %s", s.keyPath)
+	}
+	return key, nil
+}
+
+func (s *Store) readOrCreateKey() ([]byte, error) {
+	if _, err := os.Stat(s.keyPath); err == nil {
+		return s.readKey()
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	if err := renameio.WriteFile(s.keyPath, key, 0600); err != nil {
+		return nil, fmt.Errorf("unable to write secrets key: %w", err)
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+var referenceRegex = regexp.MustCompile(`\$\{secret:([a-zA-Z0-9_.-]+)\}`)
+
+// References returns the names of the secrets referenced by the value.
+func References(value string) []string {
+	var names []string
+	for _, match := range referenceRegex.FindAllStringSubmatch(value, -1) {
+		if !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// Resolve replaces the secret references contained in the value with the
+// corresponding secrets. It fails if a referenced secret is not set.
+func Resolve(value string, values map[string]string) (string, error) {
+	var missing []string
+	resolved := referenceRegex.ReplaceAllStringFunc(value, func(ref string) string {
+		name := referenceRegex.FindStringSubmatch(ref)[1]
+		secret, found := values[name]
+		if !found {
+			missing = append(missing, name)
+			return ref
+		}
+		return secret
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %q", ErrSecretNotFound, missing[0])
+	}
+	return resolved, nil
+}
@@ -0,0 +1,140 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+// sketchBuildCache describes the last successful build of the sketch of an app,
+// the build is reused as long as its key doesn't change.
+type sketchBuildCache struct {
+	Key      string              `json:"key"`
+	Platform sketchBuildPlatform `json:"platform"`
+	// Libraries contains the install dirs of the libraries used by the build.
+	Libraries []string  `json:"libraries"`
+	BuiltAt   time.Time `json:"built_at"`
+}
+
+// sketchBuildPlatform identifies the platform of the board used by the build.
+type sketchBuildPlatform struct {
+	ID         string `json:"id"`
+	Version    string `json:"version"`
+	InstallDir string `json:"install_dir"`
+}
+
+// sketchBuildKey hashes everything that affects the build of the sketch: the sketch
+// sources (including the sketch project file), the profile, the FQBN, the platform of
+// the board and the sources of the used libraries.
+func sketchBuildKey(a *app.ArduinoApp, profile, fqbn string, platform sketchBuildPlatform, libraries []string) (string, error) {
+	sketchHash, err := hashDir(a.MainSketchPath)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "sketch=%s\x00profile=%s\x00fqbn=%s\x00", sketchHash, profile, fqbn)
+	_, _ = fmt.Fprintf(h, "platform=%s\x00version=%s\x00platform_dir=%s\x00", platform.ID, platform.Version, platform.InstallDir)
+	for _, lib := range libraries {
+		libDir := paths.New(lib)
+		if !libDir.IsDir() {
+			return "", fmt.Errorf("library %q not found", lib)
+		}
+		libHash, err := hashDir(libDir)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(h, "library=%s\x00%s\x00", lib, libHash)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func readSketchBuildCache(a *app.ArduinoApp) (*sketchBuildCache, error) {
+	data, err := a.SketchBuildCacheFilePath().ReadFile()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var cache sketchBuildCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("invalid sketch build cache: %w", err)
+	}
+	return &cache, nil
+}
+
+func saveSketchBuildCache(a *app.ArduinoApp, profile, fqbn string, platform sketchBuildPlatform, libraries []string) error {
+	key, err := sketchBuildKey(a, profile, fqbn, platform, libraries)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(sketchBuildCache{
+		Key:       key,
+		Platform:  platform,
+		Libraries: libraries,
+		BuiltAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := a.ProvisioningStateDir().MkdirAll(); err != nil {
+		return err
+	}
+	return fatomic.WriteFile(a.SketchBuildCacheFilePath().String(), data, os.FileMode(0644))
+}
+
+func clearSketchBuildCache(a *app.ArduinoApp) error {
+	if err := a.SketchBuildCacheFilePath().Remove(); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// isSketchBuildCached reports whether the last build of the sketch can be uploaded
+// without compiling the sketch again. The build is stale if the platform of the board,
+// whose installed version is platformVersion, has been upgraded or removed since then.
+func isSketchBuildCached(a *app.ArduinoApp, profile, fqbn, platformVersion string) bool {
+	cache, err := readSketchBuildCache(a)
+	if err != nil || cache == nil {
+		return false
+	}
+	if cache.Platform.Version != platformVersion || !paths.New(cache.Platform.InstallDir).IsDir() {
+		return false
+	}
+	key, err := sketchBuildKey(a, profile, fqbn, cache.Platform, cache.Libraries)
+	if err != nil || key != cache.Key {
+		return false
+	}
+
+	// The build folder may have been removed in the meantime.
+	files, err := a.SketchBuildPath().ReadDir()
+	if err != nil {
+		return false
+	}
+	files.FilterPrefix(a.MainSketchPath.Base() + ".ino.")
+	return len(files) > 0
+}
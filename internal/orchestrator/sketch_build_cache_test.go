@@ -0,0 +1,117 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestSketchBuildCache(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	const fqbn = sketchFQBNRam
+	libDir := paths.New(t.TempDir()).Join("MyLib")
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte("name=MyLib\nversion=1.0.0\n")))
+	require.NoError(t, libDir.Join("src", "MyLib.h").WriteFile([]byte("#define MY_LIB 1\n")))
+	platform := sketchBuildPlatform{ID: "arduino:zephyr", Version: "0.50.0", InstallDir: t.TempDir()}
+
+	require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+	require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String()}))
+	require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version), "the build output is missing")
+
+	require.NoError(t, a.SketchBuildPath().MkdirAll())
+	require.NoError(t, a.SketchBuildPath().Join("sketch.ino.elf-zsk.bin").WriteFile([]byte("binary")))
+	require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+	t.Run("different profile", func(t *testing.T) {
+		require.False(t, isSketchBuildCached(&a, "other", fqbn, platform.Version))
+		require.False(t, isSketchBuildCached(&a, "default", "arduino:zephyr:other", platform.Version))
+	})
+
+	t.Run("different flash mode", func(t *testing.T) {
+		require.False(t, isSketchBuildCached(&a, "default", sketchFQBNFlash, platform.Version))
+	})
+
+	t.Run("library updated", func(t *testing.T) {
+		require.NoError(t, libDir.Join("library.properties").WriteFile([]byte("name=MyLib\nversion=1.1.0\n")))
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+	})
+
+	t.Run("library sources changed", func(t *testing.T) {
+		require.NoError(t, libDir.Join("src", "MyLib.h").WriteFile([]byte("#define MY_LIB 2\n")))
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+		require.NoError(t, libDir.Join("src", "MyLib.cpp").WriteFile([]byte("int myLib() { return 1; }\n")))
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+	})
+
+	t.Run("platform changed", func(t *testing.T) {
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, "0.51.0"))
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, ""))
+
+		upgraded := sketchBuildPlatform{ID: platform.ID, Version: "0.51.0", InstallDir: t.TempDir()}
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, upgraded, []string{libDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, upgraded.Version))
+		require.NoError(t, paths.New(upgraded.InstallDir).RemoveAll())
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, upgraded.Version))
+
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+	})
+
+	t.Run("library removed", func(t *testing.T) {
+		otherLibDir := paths.New(t.TempDir()).Join("OtherLib")
+		require.NoError(t, otherLibDir.MkdirAll())
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String(), otherLibDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+		require.NoError(t, otherLibDir.RemoveAll())
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, []string{libDir.String()}))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+	})
+
+	t.Run("sketch changed", func(t *testing.T) {
+		require.NoError(t, a.MainSketchPath.Join("sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+	})
+
+	t.Run("cleared", func(t *testing.T) {
+		require.NoError(t, saveSketchBuildCache(&a, "default", fqbn, platform, nil))
+		require.True(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+		require.NoError(t, clearSketchBuildCache(&a))
+		require.False(t, isSketchBuildCached(&a, "default", fqbn, platform.Version))
+	})
+}
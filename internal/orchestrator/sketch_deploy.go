@@ -0,0 +1,88 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"log/slog"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+// DeploySketch compiles and uploads the sketch of the app to the micro, without
+// starting the python part of the app. The sketch is burnt in the flash of the
+// micro if flash is true or if the app is configured to deploy it in flash. Unless force
+// is set, the sketch of another app stored in the flash of the micro is never overwritten.
+func DeploySketch(ctx context.Context, a app.ArduinoApp, cfg config.Configuration, flash, force bool) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		if a.MainSketchPath == nil {
+			yield(StreamMessage{error: ErrSketchNotFound})
+			return
+		}
+		loaded, err := readSketchState(cfg)
+		if err != nil {
+			slog.Warn("unable to read sketch state", slog.String("error", err.Error()))
+		}
+		if loaded != nil && loaded.AppPath != a.FullPath.String() && isSketchLoaded(loaded) {
+			yield(StreamMessage{error: fmt.Errorf("%w: the micro is running the sketch of the app %q, stop it first", ErrAppConflict, flashOwnerName(loaded))})
+			return
+		}
+
+		if !yield(StreamMessage{progress: &Progress{Name: "sketch compiling and uploading", Progress: 0.0}}) {
+			return
+		}
+		callbackWriter := NewCallbackWriter(func(line string) {
+			if !yield(StreamMessage{data: line}) {
+				cancel()
+				return
+			}
+		})
+		opts := sketchBuildOptions{
+			flash: flash,
+			force: force,
+			onDiagnostic: func(d SketchDiagnostic) {
+				if ctx.Err() != nil {
+					return
+				}
+				if !yield(StreamMessage{diagnostic: &d}) {
+					cancel()
+				}
+			},
+			onCacheHit: func() {
+				if ctx.Err() != nil {
+					return
+				}
+				if !yield(StreamMessage{progress: &Progress{Name: "sketch unchanged, using cached build", Progress: 50.0}}) {
+					cancel()
+				}
+			},
+		}
+		if err := compileUploadSketch(ctx, &a, cfg, callbackWriter, opts); err != nil {
+			yield(StreamMessage{error: err})
+			return
+		}
+		if err := saveSketchState(cfg, &a); err != nil {
+			slog.Warn("unable to save sketch state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+		}
+		_ = yield(StreamMessage{progress: &Progress{Name: "", Progress: 100.0}})
+	}
+}
@@ -0,0 +1,151 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"iter"
+	"path/filepath"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+var ErrSketchNotFound = errors.New("the app has no sketch")
+
+// diagnosticSnippetLines is the number of source lines reported before and
+// after the line of a compiler diagnostic.
+const diagnosticSnippetLines = 2
+
+// SketchDiagnostic is a problem reported by the compiler while building the
+// sketch of the app. File is relative to the app folder when the problem is
+// in the app itself, Line and Column are 1-based and zero when not available.
+type SketchDiagnostic struct {
+	Severity DiagnosticSeverity `json:"severity" required:"true"`
+	Message  string             `json:"message" required:"true"`
+	File     string             `json:"file,omitempty"`
+	Line     int                `json:"line,omitempty"`
+	Column   int                `json:"column,omitempty"`
+	// Snippet contains the source lines around the problem.
+	Snippet []SketchSourceLine `json:"snippet,omitempty"`
+}
+
+type SketchSourceLine struct {
+	Line int    `json:"line" required:"true"`
+	Text string `json:"text" required:"true"`
+}
+
+// CompileSketch compiles the sketch of the app without uploading it to the micro.
+func CompileSketch(ctx context.Context, a app.ArduinoApp, cfg config.Configuration) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		if a.MainSketchPath == nil {
+			yield(StreamMessage{error: ErrSketchNotFound})
+			return
+		}
+		if !yield(StreamMessage{progress: &Progress{Name: "sketch compiling", Progress: 0.0}}) {
+			return
+		}
+		callbackWriter := NewCallbackWriter(func(line string) {
+			if !yield(StreamMessage{data: line}) {
+				cancel()
+				return
+			}
+		})
+		onDiagnostic := func(d SketchDiagnostic) {
+			if ctx.Err() != nil {
+				return
+			}
+			if !yield(StreamMessage{diagnostic: &d}) {
+				cancel()
+			}
+		}
+		if err := buildSketch(ctx, &a, cfg, callbackWriter, sketchBuildOptions{onDiagnostic: onDiagnostic}); err != nil {
+			yield(StreamMessage{error: err})
+			return
+		}
+		_ = yield(StreamMessage{progress: &Progress{Name: "", Progress: 100.0}})
+	}
+}
+
+func parseCompileDiagnostics(a *app.ArduinoApp, diagnostics []*rpc.CompileDiagnostic) []SketchDiagnostic {
+	res := make([]SketchDiagnostic, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		severity := DiagnosticError
+		if d.GetSeverity() == "WARNING" {
+			severity = DiagnosticWarning
+		}
+		diagnostic := SketchDiagnostic{
+			Severity: severity,
+			Message:  d.GetMessage(),
+			Line:     int(d.GetLine()),
+			Column:   int(d.GetColumn()),
+		}
+		if d.GetFile() != "" {
+			file := sketchSourcePath(a, paths.New(d.GetFile()))
+			diagnostic.File = file.String()
+			if inside, _ := file.IsInsideDir(a.FullPath); inside {
+				if rel, err := file.RelFrom(a.FullPath); err == nil {
+					diagnostic.File = filepath.ToSlash(rel.String())
+				}
+			}
+			if diagnostic.Line > 0 {
+				diagnostic.Snippet = readSourceSnippet(file, diagnostic.Line, diagnosticSnippetLines)
+			}
+		}
+		res = append(res, diagnostic)
+	}
+	return res
+}
+
+// sketchSourcePath maps a file of the sketch copied in the build folder back to
+// the original file in the sketch folder.
+func sketchSourcePath(a *app.ArduinoApp, file *paths.Path) *paths.Path {
+	buildSketchDir := a.SketchBuildPath().Join("sketch")
+	if ok, _ := file.IsInsideDir(buildSketchDir); !ok {
+		return file
+	}
+	rel, err := file.RelFrom(buildSketchDir)
+	if err != nil {
+		return file
+	}
+	return a.MainSketchPath.JoinPath(rel)
+}
+
+// readSourceSnippet returns the lines of the file around the given 1-based line.
+func readSourceSnippet(file *paths.Path, line int, around int) []SketchSourceLine {
+	f, err := file.Open()
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var snippet []SketchSourceLine
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan() && n <= line+around; n++ {
+		if n >= line-around {
+			snippet = append(snippet, SketchSourceLine{Line: n, Text: scanner.Text()})
+		}
+	}
+	return snippet
+}
@@ -0,0 +1,98 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestParseCompileDiagnostics(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	src := "#include \"helper.h\"\n\nvoid setup() {\n  x = 1;\n}\n\nvoid loop() {}\n"
+	require.NoError(t, a.MainSketchPath.Join("sketch.ino").WriteFile([]byte(src)))
+	require.NoError(t, a.MainSketchPath.Join("helper.h").WriteFile([]byte("int y = 0\n")))
+
+	diagnostics := parseCompileDiagnostics(&a, []*rpc.CompileDiagnostic{
+		{
+			Severity: "ERROR",
+			Message:  "'x' was not declared in this scope",
+			File:     a.MainSketchPath.Join("sketch.ino").String(),
+			Line:     4,
+			Column:   3,
+		},
+		{
+			// files of the sketch are copied in the build folder before being compiled
+			Severity: "WARNING",
+			Message:  "expected ',' or ';' at end of input",
+			File:     a.SketchBuildPath().Join("sketch", "helper.h").String(),
+			Line:     1,
+			Column:   10,
+		},
+		{
+			Severity: "FATAL",
+			Message:  "missing.h: No such file or directory",
+			File:     "/nonexistent/include/other.h",
+			Line:     12,
+		},
+		{
+			Severity: "ERROR",
+			Message:  "ld returned 1 exit status",
+		},
+	})
+	require.Equal(t, []SketchDiagnostic{
+		{
+			Severity: DiagnosticError,
+			Message:  "'x' was not declared in this scope",
+			File:     "sketch/sketch.ino",
+			Line:     4,
+			Column:   3,
+			Snippet: []SketchSourceLine{
+				{Line: 2, Text: ""},
+				{Line: 3, Text: "void setup() {"},
+				{Line: 4, Text: "  x = 1;"},
+				{Line: 5, Text: "}"},
+				{Line: 6, Text: ""},
+			},
+		},
+		{
+			Severity: DiagnosticWarning,
+			Message:  "expected ',' or ';' at end of input",
+			File:     "sketch/helper.h",
+			Line:     1,
+			Column:   10,
+			Snippet:  []SketchSourceLine{{Line: 1, Text: "int y = 0"}},
+		},
+		{
+			Severity: DiagnosticError,
+			Message:  "missing.h: No such file or directory",
+			File:     "/nonexistent/include/other.h",
+			Line:     12,
+		},
+		{
+			Severity: DiagnosticError,
+			Message:  "ld returned 1 exit status",
+		},
+	}, diagnostics)
+}
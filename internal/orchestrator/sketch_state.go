@@ -0,0 +1,256 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/micro"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+const (
+	sketchStateFileName = "sketch-state.json"
+	flashStateFileName  = "flash-sketch.json"
+)
+
+// AppSketchInfo describes the sketch of an app loaded on the micro.
+type AppSketchInfo struct {
+	Hash       string    `json:"hash" required:"true"`
+	UploadedAt time.Time `json:"uploaded_at" required:"true"`
+	// Modified is true when the sketch of the app has been changed after the upload.
+	Modified bool `json:"modified"`
+}
+
+// sketchState records the sketch currently loaded on the micro, or the sketch
+// stored in the flash of the micro.
+type sketchState struct {
+	AppPath    string    `json:"app_path"`
+	Hash       string    `json:"hash"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+var sketchStateMux sync.Mutex
+
+func readSketchState(cfg config.Configuration) (*sketchState, error) {
+	return readSketchStateFile(cfg, sketchStateFileName)
+}
+
+// saveSketchState records the sketch of the app as the one loaded on the micro.
+func saveSketchState(cfg config.Configuration, a *app.ArduinoApp) error {
+	return saveSketchStateFile(cfg, sketchStateFileName, a)
+}
+
+func clearSketchState(cfg config.Configuration) error {
+	return clearSketchStateFile(cfg, sketchStateFileName)
+}
+
+// readFlashState returns the owner of the sketch stored in the flash of the micro.
+func readFlashState(cfg config.Configuration) (*sketchState, error) {
+	return readSketchStateFile(cfg, flashStateFileName)
+}
+
+func saveFlashState(cfg config.Configuration, a *app.ArduinoApp) error {
+	return saveSketchStateFile(cfg, flashStateFileName, a)
+}
+
+func clearFlashState(cfg config.Configuration) error {
+	return clearSketchStateFile(cfg, flashStateFileName)
+}
+
+// FlashOwnedError is returned when uploading a sketch would overwrite the sketch of
+// another app stored in the flash of the micro.
+type FlashOwnedError struct {
+	// Owner is the name of the app owning the flash contents.
+	Owner string
+}
+
+func (e *FlashOwnedError) Error() string {
+	return fmt.Sprintf("the flash of the micro stores the sketch of the app %q, it would be overwritten", e.Owner)
+}
+
+// checkFlashOwner returns a FlashOwnedError if the flash of the micro stores the sketch of
+// another app, unless force is set. Uploading the sketch overwrites the flash contents even
+// in RAM mode, since the micro must be configured in RAM mode first.
+func checkFlashOwner(cfg config.Configuration, a *app.ArduinoApp, force bool) error {
+	if force {
+		return nil
+	}
+	state, err := readFlashState(cfg)
+	if err != nil {
+		slog.Warn("unable to read flash state", slog.String("error", err.Error()))
+		return nil
+	}
+	if state == nil || state.AppPath == a.FullPath.String() {
+		return nil
+	}
+	return &FlashOwnedError{Owner: flashOwnerName(state)}
+}
+
+func readSketchStateFile(cfg config.Configuration, name string) (*sketchState, error) {
+	sketchStateMux.Lock()
+	defer sketchStateMux.Unlock()
+
+	data, err := cfg.DataDir().Join(name).ReadFile()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var state sketchState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid sketch state: %w", err)
+	}
+	return &state, nil
+}
+
+func saveSketchStateFile(cfg config.Configuration, name string, a *app.ArduinoApp) error {
+	hash, err := hashDir(a.MainSketchPath)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(sketchState{
+		AppPath:    a.FullPath.String(),
+		Hash:       hash,
+		UploadedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	sketchStateMux.Lock()
+	defer sketchStateMux.Unlock()
+	if err := cfg.DataDir().MkdirAll(); err != nil {
+		return err
+	}
+	return fatomic.WriteFile(cfg.DataDir().Join(name).String(), data, os.FileMode(0644))
+}
+
+func clearSketchStateFile(cfg config.Configuration, name string) error {
+	sketchStateMux.Lock()
+	defer sketchStateMux.Unlock()
+
+	if err := cfg.DataDir().Join(name).Remove(); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// hashDir computes a hash of the content of all the files of the folder,
+// the Python bytecode caches are skipped.
+func hashDir(dir *paths.Path) (string, error) {
+	files, err := dir.ReadDirRecursiveFiltered(paths.FilterOutNames("__pycache__"))
+	if err != nil {
+		return "", err
+	}
+	files.FilterOutDirs()
+	files.FilterOutSuffix(".pyc")
+	files.Sort()
+
+	h := sha256.New()
+	for _, file := range files {
+		rel, err := file.RelFrom(dir)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00", filepath.ToSlash(rel.String()))
+		if err := hashFile(h, file); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, file *paths.Path) error {
+	f, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// isSketchLoaded reports whether the recorded sketch is still running on the micro.
+// If the micro state cannot be read, the recorded state is trusted.
+func isSketchLoaded(state *sketchState) bool {
+	if state == nil {
+		return false
+	}
+	enabled, err := micro.IsEnabled()
+	if err != nil {
+		slog.Debug("unable to read the micro state", slog.String("error", err.Error()))
+		return true
+	}
+	return enabled
+}
+
+// getSketchAppStatus returns the status of the sketch-only app loaded on the micro,
+// or nil if the micro is not running the sketch of such an app.
+// The status of the apps with a python part is tracked through their containers.
+func getSketchAppStatus(cfg config.Configuration) (*AppStatusInfo, error) {
+	state, err := readSketchState(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !isSketchLoaded(state) {
+		return nil, nil
+	}
+	a, err := app.Load(state.AppPath)
+	if err != nil {
+		slog.Debug("unable to load the app of the loaded sketch", slog.String("path", state.AppPath), slog.String("error", err.Error()))
+		return nil, nil
+	}
+	if a.MainPythonFile != nil || a.MainSketchPath == nil {
+		return nil, nil
+	}
+	return &AppStatusInfo{AppPath: a.FullPath, Status: StatusRunning}, nil
+}
+
+// getAppSketchInfo returns the info about the sketch of the app loaded on the micro,
+// or nil if the micro is not running the sketch of the app.
+func getAppSketchInfo(cfg config.Configuration, a *app.ArduinoApp) *AppSketchInfo {
+	if a.MainSketchPath == nil {
+		return nil
+	}
+	state, err := readSketchState(cfg)
+	if err != nil {
+		slog.Warn("unable to read sketch state", slog.String("error", err.Error()))
+		return nil
+	}
+	if state == nil || state.AppPath != a.FullPath.String() || !isSketchLoaded(state) {
+		return nil
+	}
+	info := &AppSketchInfo{Hash: state.Hash, UploadedAt: state.UploadedAt}
+	if hash, err := hashDir(a.MainSketchPath); err == nil {
+		info.Modified = hash != state.Hash
+	}
+	return info
+}
@@ -0,0 +1,160 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestHashDir(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	hash, err := hashDir(a.MainSketchPath)
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	again, err := hashDir(a.MainSketchPath)
+	require.NoError(t, err)
+	require.Equal(t, hash, again)
+
+	require.NoError(t, a.MainSketchPath.Join("extra.h").WriteFile([]byte("#define FOO 1\n")))
+	modified, err := hashDir(a.MainSketchPath)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, modified)
+
+	require.NoError(t, a.MainSketchPath.Join("__pycache__").MkdirAll())
+	require.NoError(t, a.MainSketchPath.Join("__pycache__", "main.cpython-313.pyc").WriteFile([]byte("bytecode")))
+	require.NoError(t, a.MainSketchPath.Join("cached.pyc").WriteFile([]byte("bytecode")))
+	withCache, err := hashDir(a.MainSketchPath)
+	require.NoError(t, err)
+	require.Equal(t, modified, withCache)
+}
+
+func TestSketchAppStatus(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	res, err := CreateApp(t.Context(), CreateAppRequest{Name: "sketch-only", SkipPython: true}, idProvider, cfg)
+	require.NoError(t, err)
+	sketchApp, err := app.Load(res.ID.ToPath().String())
+	require.NoError(t, err)
+	pythonApp, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	t.Run("nothing loaded", func(t *testing.T) {
+		status, err := getSketchAppStatus(cfg)
+		require.NoError(t, err)
+		require.Nil(t, status)
+		require.Nil(t, getAppSketchInfo(cfg, &sketchApp))
+	})
+
+	t.Run("sketch-only app loaded", func(t *testing.T) {
+		require.NoError(t, saveSketchState(cfg, &sketchApp))
+
+		status, err := getSketchAppStatus(cfg)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		require.True(t, status.AppPath.EqualsTo(sketchApp.FullPath))
+		require.Equal(t, StatusRunning, status.Status)
+
+		info := getAppSketchInfo(cfg, &sketchApp)
+		require.NotNil(t, info)
+		require.False(t, info.Modified)
+		require.Nil(t, getAppSketchInfo(cfg, &pythonApp))
+
+		require.NoError(t, sketchApp.MainSketchPath.Join("sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+		info = getAppSketchInfo(cfg, &sketchApp)
+		require.NotNil(t, info)
+		require.True(t, info.Modified)
+	})
+
+	t.Run("app with python is tracked by its containers", func(t *testing.T) {
+		require.NoError(t, saveSketchState(cfg, &pythonApp))
+
+		status, err := getSketchAppStatus(cfg)
+		require.NoError(t, err)
+		require.Nil(t, status)
+		require.NotNil(t, getAppSketchInfo(cfg, &pythonApp))
+	})
+
+	t.Run("cleared", func(t *testing.T) {
+		require.NoError(t, clearSketchState(cfg))
+		require.NoError(t, clearSketchState(cfg))
+
+		state, err := readSketchState(cfg)
+		require.NoError(t, err)
+		require.Nil(t, state)
+	})
+}
+
+func TestFlashState(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	state, err := readFlashState(cfg)
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	require.NoError(t, saveFlashState(cfg, &a))
+	state, err = readFlashState(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	require.Equal(t, a.FullPath.String(), state.AppPath)
+	require.Equal(t, "app1", flashOwnerName(state))
+
+	// The flash contents are tracked apart from the sketch loaded on the micro.
+	loaded, err := readSketchState(cfg)
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+
+	require.NoError(t, a.FullPath.RemoveAll())
+	require.Equal(t, a.FullPath.String(), flashOwnerName(state))
+
+	require.NoError(t, clearFlashState(cfg))
+	state, err = readFlashState(cfg)
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
+
+func TestCheckFlashOwner(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	a, err := app.Load(createApp(t, "app1", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+	b, err := app.Load(createApp(t, "app2", false, idProvider, cfg).ToPath().String())
+	require.NoError(t, err)
+
+	require.NoError(t, checkFlashOwner(cfg, &a, false), "the flash is empty")
+
+	require.NoError(t, saveFlashState(cfg, &a))
+	require.NoError(t, checkFlashOwner(cfg, &a, false), "the app owns the flash")
+
+	err = checkFlashOwner(cfg, &b, false)
+	var flashOwned *FlashOwnedError
+	require.ErrorAs(t, err, &flashOwned)
+	require.Equal(t, "app1", flashOwned.Owner)
+
+	require.NoError(t, checkFlashOwner(cfg, &b, true))
+}
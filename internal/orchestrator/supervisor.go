@@ -0,0 +1,424 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/micro"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+const (
+	// restartStableAfter is the time after which a restarted app is considered
+	// healthy again, and the consecutive restarts counter is reset.
+	restartStableAfter = time.Minute
+	// microResetDebounce groups the reset line events generated by a single reset.
+	microResetDebounce = 2 * time.Second
+	// dockerEventsRetryDelay is the delay before listening again to the docker
+	// events after the stream has been interrupted.
+	dockerEventsRetryDelay = 5 * time.Second
+)
+
+// AppRestartInfo reports the restarts made by the daemon to enforce the restart
+// policy of the app, since the last time the app has been started.
+type AppRestartInfo struct {
+	Count int `json:"count"`
+	// Retries counts the consecutive restarts, it is reset once the app runs
+	// without failing for restartStableAfter.
+	Retries          int        `json:"retries"`
+	RetriesExhausted bool       `json:"retries_exhausted,omitempty"`
+	SketchReuploads  int        `json:"sketch_reuploads,omitempty"`
+	LastExitCode     *int       `json:"last_exit_code,omitempty"`
+	LastExitReason   string     `json:"last_exit_reason,omitempty"`
+	LastExitAt       *time.Time `json:"last_exit_at,omitempty"`
+	LastRestartAt    *time.Time `json:"last_restart_at,omitempty"`
+}
+
+type appRestartState struct {
+	AppRestartInfo
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+}
+
+var restartStateMux sync.Mutex
+
+func readAppRestartState(a *app.ArduinoApp) (appRestartState, error) {
+	var state appRestartState
+	data, err := a.RestartStateFilePath().ReadFile()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return state, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return appRestartState{}, fmt.Errorf("invalid restart state: %w", err)
+	}
+	return state, nil
+}
+
+func updateAppRestartState(a *app.ArduinoApp, update func(state *appRestartState)) error {
+	restartStateMux.Lock()
+	defer restartStateMux.Unlock()
+
+	state, err := readAppRestartState(a)
+	if err != nil {
+		slog.Warn("discarding restart state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+		state = appRestartState{}
+	}
+	update(&state)
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := a.ProvisioningStateDir().MkdirAll(); err != nil {
+		return err
+	}
+	return fatomic.WriteFile(a.RestartStateFilePath().String(), data, os.FileMode(0644))
+}
+
+// resetAppRestartState clears the restarts history, it is called when the app is started.
+func resetAppRestartState(a *app.ArduinoApp) error {
+	return updateAppRestartState(a, func(state *appRestartState) {
+		*state = appRestartState{}
+	})
+}
+
+// markAppStopped records that the app has been stopped on purpose, so that
+// pending restarts of its containers are dropped.
+func markAppStopped(a *app.ArduinoApp) error {
+	return updateAppRestartState(a, func(state *appRestartState) {
+		now := time.Now()
+		state.StoppedAt = &now
+	})
+}
+
+func getAppRestartInfo(a *app.ArduinoApp) *AppRestartInfo {
+	state, err := readAppRestartState(a)
+	if err != nil {
+		slog.Warn("unable to read restart state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+		return nil
+	}
+	if state.AppRestartInfo == (AppRestartInfo{}) {
+		return nil
+	}
+	return &state.AppRestartInfo
+}
+
+// nextRestart applies the restart policy to a container exited with exitCode,
+// after the given number of consecutive restarts. It returns whether the container
+// must be restarted and the delay to wait before doing it. exhausted is true if
+// the policy would restart the container but the maximum retries have been reached.
+func nextRestart(policy app.RestartPolicy, retries int, exitCode int) (delay time.Duration, restart bool, exhausted bool) {
+	switch policy.Mode {
+	case app.RestartAlways:
+	case app.RestartOnFailure:
+		if exitCode == 0 {
+			return 0, false, false
+		}
+	default:
+		return 0, false, false
+	}
+	if policy.MaxRetries > 0 && retries >= policy.MaxRetries {
+		return 0, false, true
+	}
+	return policy.BackoffDelay(retries), true, false
+}
+
+func exitReason(containerName string, exitCode int, oomKilled bool) string {
+	if oomKilled {
+		return fmt.Sprintf("container %q was killed: out of memory", containerName)
+	}
+	return fmt.Sprintf("container %q exited with code %d", containerName, exitCode)
+}
+
+// Supervisor enforces the restart policy of the apps: it restarts the containers
+// that die unexpectedly and re-uploads the sketch of the running apps when the
+// micro is reset.
+type Supervisor struct {
+	docker command.Cli
+	cfg    config.Configuration
+
+	mu               sync.Mutex
+	stoppedOnPurpose map[string]bool
+	oomKilled        map[string]bool
+	pendingRestarts  map[string]*time.Timer
+	resetTimer       *time.Timer
+	lastResetAt      time.Time
+	uploading        bool
+	ignoreResetUntil time.Time
+}
+
+func NewSupervisor(docker command.Cli, cfg config.Configuration) *Supervisor {
+	return &Supervisor{
+		docker:           docker,
+		cfg:              cfg,
+		stoppedOnPurpose: map[string]bool{},
+		oomKilled:        map[string]bool{},
+		pendingRestarts:  map[string]*time.Timer{},
+	}
+}
+
+// Run supervises the apps until the context is done.
+func (s *Supervisor) Run(ctx context.Context) {
+	if err := micro.WatchReset(ctx, func() { s.onMicroReset(ctx) }); err != nil {
+		slog.Warn("unable to watch the micro reset, sketches will not be re-uploaded", slog.String("error", err.Error()))
+	}
+
+	for {
+		s.watchContainers(ctx)
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			for id, timer := range s.pendingRestarts {
+				timer.Stop()
+				delete(s.pendingRestarts, id)
+			}
+			s.mu.Unlock()
+			return
+		case <-time.After(dockerEventsRetryDelay):
+		}
+	}
+}
+
+func (s *Supervisor) watchContainers(ctx context.Context) {
+	chanMsg, chanError := s.docker.Client().Events(ctx, events.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("label", DockerAppLabel+"=true"),
+			filters.Arg("type", string(events.ContainerEventType)),
+			filters.Arg("event", string(events.ActionKill)),
+			filters.Arg("event", string(events.ActionOOM)),
+			filters.Arg("event", string(events.ActionDie)),
+			filters.Arg("event", string(events.ActionStart)),
+			filters.Arg("event", string(events.ActionDestroy)),
+		),
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-chanError:
+			if err != nil && ctx.Err() == nil {
+				slog.Error("Error listening to docker events", slog.String("error", err.Error()))
+			}
+			return
+		case event := <-chanMsg:
+			s.handleContainerEvent(ctx, event)
+		}
+	}
+}
+
+func (s *Supervisor) handleContainerEvent(ctx context.Context, event events.Message) {
+	appPath, ok := event.Actor.Attributes[DockerAppPathLabel]
+	if !ok {
+		return
+	}
+	id := event.Actor.ID
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch event.Action {
+	case events.ActionKill:
+		// Docker kills the containers only when they are stopped on purpose.
+		s.stoppedOnPurpose[id] = true
+		s.cancelRestart(id)
+	case events.ActionOOM:
+		s.oomKilled[id] = true
+	case events.ActionStart:
+		delete(s.stoppedOnPurpose, id)
+		s.cancelRestart(id)
+	case events.ActionDestroy:
+		delete(s.stoppedOnPurpose, id)
+		delete(s.oomKilled, id)
+		s.cancelRestart(id)
+	case events.ActionDie:
+		if s.stoppedOnPurpose[id] {
+			delete(s.stoppedOnPurpose, id)
+			return
+		}
+		exitCode, _ := strconv.Atoi(event.Actor.Attributes["exitCode"])
+		oomKilled := s.oomKilled[id]
+		delete(s.oomKilled, id)
+		s.onContainerDied(ctx, id, event.Actor.Attributes["name"], appPath, exitCode, oomKilled)
+	}
+}
+
+func (s *Supervisor) onContainerDied(ctx context.Context, id, name, appPath string, exitCode int, oomKilled bool) {
+	a, err := app.Load(appPath)
+	if err != nil {
+		slog.Warn("unable to load the app of a dead container", slog.String("app", appPath), slog.String("error", err.Error()))
+		return
+	}
+	reason := exitReason(name, exitCode, oomKilled)
+	policy := a.Descriptor.GetRestartPolicy()
+
+	var delay time.Duration
+	var restart bool
+	err = updateAppRestartState(&a, func(state *appRestartState) {
+		now := time.Now()
+		state.LastExitCode = &exitCode
+		state.LastExitReason = reason
+		state.LastExitAt = &now
+		if state.LastRestartAt != nil && now.Sub(*state.LastRestartAt) > restartStableAfter {
+			state.Retries = 0
+		}
+		delay, restart, state.RetriesExhausted = nextRestart(policy, state.Retries, exitCode)
+	})
+	if err != nil {
+		slog.Error("unable to update restart state", slog.String("app", appPath), slog.String("error", err.Error()))
+	}
+	slog.Info("app container died", slog.String("app", appPath), slog.String("reason", reason), slog.Bool("restart", restart), slog.Duration("delay", delay))
+	if !restart {
+		return
+	}
+
+	diedAt := time.Now()
+	s.cancelRestart(id)
+	s.pendingRestarts[id] = time.AfterFunc(delay, func() {
+		s.restartContainer(ctx, &a, id, diedAt)
+	})
+}
+
+func (s *Supervisor) cancelRestart(id string) {
+	if timer, ok := s.pendingRestarts[id]; ok {
+		timer.Stop()
+		delete(s.pendingRestarts, id)
+	}
+}
+
+func (s *Supervisor) restartContainer(ctx context.Context, a *app.ArduinoApp, id string, diedAt time.Time) {
+	s.mu.Lock()
+	delete(s.pendingRestarts, id)
+	s.mu.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+
+	if state, err := readAppRestartState(a); err == nil && state.StoppedAt != nil && state.StoppedAt.After(diedAt) {
+		slog.Debug("app stopped meanwhile, restart skipped", slog.String("app", a.FullPath.String()))
+		return
+	}
+
+	if err := s.docker.Client().ContainerStart(ctx, id, container.StartOptions{}); err != nil {
+		slog.Error("unable to restart app container", slog.String("app", a.FullPath.String()), slog.String("container", id), slog.String("error", err.Error()))
+		return
+	}
+	err := updateAppRestartState(a, func(state *appRestartState) {
+		now := time.Now()
+		state.Count++
+		state.Retries++
+		state.LastRestartAt = &now
+	})
+	if err != nil {
+		slog.Error("unable to update restart state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+	}
+}
+
+func (s *Supervisor) onMicroReset(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.uploading || time.Now().Before(s.ignoreResetUntil) {
+		return
+	}
+	s.lastResetAt = time.Now()
+	if s.resetTimer != nil {
+		s.resetTimer.Stop()
+	}
+	s.resetTimer = time.AfterFunc(microResetDebounce, func() { s.reuploadSketches(ctx) })
+}
+
+// reuploadSketches uploads again the sketch of the running apps, the sketches
+// are uploaded in RAM so they are lost when the micro is reset.
+func (s *Supervisor) reuploadSketches(ctx context.Context) {
+	if ctx.Err() != nil {
+		return
+	}
+	state, err := readSketchState(s.cfg)
+	if err != nil {
+		slog.Warn("unable to read sketch state", slog.String("error", err.Error()))
+	}
+	s.mu.Lock()
+	resetAt := s.lastResetAt
+	s.mu.Unlock()
+	if state != nil && state.UploadedAt.After(resetAt) {
+		// The reset has been caused by the upload of the sketch.
+		return
+	}
+
+	running, err := getRunningApps(ctx, s.docker.Client(), s.cfg)
+	if err != nil {
+		slog.Error("unable to get running apps", slog.String("error", err.Error()))
+		return
+	}
+	for _, a := range running {
+		if a.MainSketchPath == nil {
+			continue
+		}
+		if a.Descriptor.GetSketchDeployMode() == app.SketchDeployFlash {
+			// The sketch is stored in flash, the micro runs it again after the reset.
+			continue
+		}
+		if a.Descriptor.GetRestartPolicy().Mode == app.RestartNever {
+			// The sketch has been lost with the reset.
+			if state != nil && state.AppPath == a.FullPath.String() {
+				if err := clearSketchState(s.cfg); err != nil {
+					slog.Error("unable to clear sketch state", slog.String("error", err.Error()))
+				}
+			}
+			continue
+		}
+		slog.Info("micro reset detected, uploading the sketch again", slog.String("app", a.FullPath.String()))
+		w := NewCallbackWriter(func(line string) { slog.Debug(line, slog.String("app", a.FullPath.String())) })
+
+		// The upload resets the micro again, those resets must be ignored.
+		s.mu.Lock()
+		s.uploading = true
+		s.mu.Unlock()
+		err := compileUploadSketch(ctx, &a, s.cfg, w, sketchBuildOptions{})
+		s.mu.Lock()
+		s.uploading = false
+		s.ignoreResetUntil = time.Now().Add(microResetDebounce)
+		s.mu.Unlock()
+
+		if err != nil {
+			slog.Error("unable to upload the sketch", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+			continue
+		}
+		if err := saveSketchState(s.cfg, &a); err != nil {
+			slog.Error("unable to save sketch state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+		}
+		err = updateAppRestartState(&a, func(state *appRestartState) {
+			state.SketchReuploads++
+		})
+		if err != nil {
+			slog.Error("unable to update restart state", slog.String("app", a.FullPath.String()), slog.String("error", err.Error()))
+		}
+	}
+}
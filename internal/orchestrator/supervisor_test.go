@@ -0,0 +1,126 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestNextRestart(t *testing.T) {
+	onFailure := app.RestartPolicy{Mode: app.RestartOnFailure, MaxRetries: 2, Backoff: "1s"}
+	always := app.RestartPolicy{Mode: app.RestartAlways}
+
+	testCases := []struct {
+		name      string
+		policy    app.RestartPolicy
+		retries   int
+		exitCode  int
+		delay     time.Duration
+		restart   bool
+		exhausted bool
+	}{
+		{name: "never", policy: app.RestartPolicy{Mode: app.RestartNever}, exitCode: 1},
+		{name: "on-failure clean exit", policy: onFailure, exitCode: 0},
+		{name: "on-failure first retry", policy: onFailure, exitCode: 1, delay: time.Second, restart: true},
+		{name: "on-failure backoff", policy: onFailure, retries: 1, exitCode: 137, delay: 2 * time.Second, restart: true},
+		{name: "on-failure exhausted", policy: onFailure, retries: 2, exitCode: 1, exhausted: true},
+		{name: "always clean exit", policy: always, exitCode: 0, delay: app.DefaultRestartBackoff, restart: true},
+		{name: "always unlimited retries", policy: always, retries: 100, exitCode: 1, delay: app.MaxRestartBackoff, restart: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delay, restart, exhausted := nextRestart(tc.policy, tc.retries, tc.exitCode)
+			require.Equal(t, tc.delay, delay)
+			require.Equal(t, tc.restart, restart)
+			require.Equal(t, tc.exhausted, exhausted)
+		})
+	}
+}
+
+func TestSupervisorContainerEvents(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	appPath := createApp(t, "app1", false, idProvider, cfg).ToPath()
+
+	a, err := app.Load(appPath.String())
+	require.NoError(t, err)
+	a.Descriptor.RestartPolicy = &app.RestartPolicy{Mode: app.RestartOnFailure, MaxRetries: 1, Backoff: "1h"}
+	require.NoError(t, a.Save())
+
+	event := func(action events.Action, exitCode string) events.Message {
+		return events.Message{
+			Action: action,
+			Actor: events.Actor{
+				ID: "container-1",
+				Attributes: map[string]string{
+					DockerAppPathLabel: appPath.String(),
+					"name":             "app1-main-1",
+					"exitCode":         exitCode,
+				},
+			},
+		}
+	}
+	s := NewSupervisor(nil, cfg)
+
+	t.Run("stopped on purpose", func(t *testing.T) {
+		s.handleContainerEvent(t.Context(), event(events.ActionKill, ""))
+		s.handleContainerEvent(t.Context(), event(events.ActionDie, "143"))
+		require.Empty(t, s.pendingRestarts)
+		require.Nil(t, getAppRestartInfo(&a))
+	})
+
+	t.Run("crash schedules a restart", func(t *testing.T) {
+		s.handleContainerEvent(t.Context(), event(events.ActionOOM, ""))
+		s.handleContainerEvent(t.Context(), event(events.ActionDie, "137"))
+		require.Contains(t, s.pendingRestarts, "container-1")
+
+		info := getAppRestartInfo(&a)
+		require.NotNil(t, info)
+		require.Equal(t, 137, *info.LastExitCode)
+		require.Equal(t, `container "app1-main-1" was killed: out of memory`, info.LastExitReason)
+		require.False(t, info.RetriesExhausted)
+
+		// The container is started by someone else, the restart is not needed anymore.
+		s.handleContainerEvent(t.Context(), event(events.ActionStart, ""))
+		require.Empty(t, s.pendingRestarts)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		require.NoError(t, updateAppRestartState(&a, func(state *appRestartState) {
+			now := time.Now()
+			state.Count = 1
+			state.Retries = 1
+			state.LastRestartAt = &now
+		}))
+		s.handleContainerEvent(t.Context(), event(events.ActionDie, "1"))
+		require.Empty(t, s.pendingRestarts)
+
+		info := getAppRestartInfo(&a)
+		require.True(t, info.RetriesExhausted)
+		require.Equal(t, `container "app1-main-1" exited with code 1`, info.LastExitReason)
+	})
+
+	t.Run("start resets the state", func(t *testing.T) {
+		require.NoError(t, resetAppRestartState(&a))
+		require.Nil(t, getAppRestartInfo(&a))
+	})
+}
@@ -37,6 +37,7 @@ import (
 	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/assets"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/store"
 )
@@ -48,16 +49,16 @@ func SystemInit(ctx context.Context, cfg config.Configuration, staticStore *stor
 	if err != nil {
 		return err
 	}
-	containersToPreinstall = append(containersToPreinstall, additionalContainers...)
+	containersToPreinstall = append(containersToPreinstall, resolveImages(cfg, additionalContainers)...)
 
-	pulledImages, err := listImagesAlreadyPulled(ctx, docker.Client())
+	pulledImages, err := listImagesAlreadyPulled(ctx, docker.Client(), cfg.Images.MirrorPrefixes()...)
 	if err != nil {
 		return err
 	}
 
 	// Filter out containers alredy pulled
 	containersToPreinstall = slices.DeleteFunc(containersToPreinstall, func(v string) bool {
-		return slices.Contains(pulledImages, v)
+		return slices.ContainsFunc(pulledImages, func(pulled string) bool { return config.SameImage(pulled, v) })
 	})
 
 	stdout, _, err := feedback.DirectStreams()
@@ -154,27 +155,66 @@ func isTemporaryDockerError(err error) bool {
 var imagePrefixes = []string{"ghcr.io/bcmi-labs/", "public.ecr.aws/arduino/", "ghcr.io/arduino/", "influxdb"}
 
 // Lists all the local docker images that could have been, or are downloaded by Arduino.
-// This is used both to avoid pulling already existing images and cleaning up unused old Arduino images.
-func listImagesAlreadyPulled(ctx context.Context, docker dockerClient.APIClient) ([]string, error) {
-	images, err := docker.ImageList(ctx, image.ListOptions{})
+// This is used to avoid pulling already existing images.
+// The images pulled from the mirrors are matched with the additional prefixes.
+func listImagesAlreadyPulled(ctx context.Context, docker dockerClient.APIClient, additionalPrefixes ...string) ([]string, error) {
+	images, err := listPulledImages(ctx, docker, additionalPrefixes...)
 	if err != nil {
 		return nil, err
 	}
 
 	result := make([]string, 0, len(images))
 	for _, image := range images {
-		for _, tag := range image.RepoTags {
-			for _, prefix := range imagePrefixes {
-				if strings.HasPrefix(tag, prefix) {
-					result = append(result, tag)
-				}
-			}
-		}
+		// The images pinned by digest are listed by their digest, even if they are tagged too.
+		result = append(result, image.tags...)
+		result = append(result, image.digests...)
+	}
+	return result, nil
+}
+
+// pulledImage holds the references of a local image matching the Arduino prefixes.
+type pulledImage struct {
+	tags    []string
+	digests []string
+}
+
+func (p pulledImage) refs() []string {
+	return slices.Concat(p.tags, p.digests)
+}
+
+// listPulledImages lists the local docker images by Arduino, with their references grouped by image.
+func listPulledImages(ctx context.Context, docker dockerClient.APIClient, additionalPrefixes ...string) ([]pulledImage, error) {
+	images, err := docker.ImageList(ctx, image.ListOptions{})
+	if err != nil {
+		return nil, err
 	}
 
+	prefixes := append(slices.Clone(imagePrefixes), additionalPrefixes...)
+	hasPrefix := func(ref string) bool {
+		return slices.ContainsFunc(prefixes, func(prefix string) bool { return strings.HasPrefix(ref, prefix) })
+	}
+	result := make([]pulledImage, 0, len(images))
+	for _, image := range images {
+		pulled := pulledImage{
+			tags:    slices.DeleteFunc(slices.Clone(image.RepoTags), func(ref string) bool { return !hasPrefix(ref) }),
+			digests: slices.DeleteFunc(slices.Clone(image.RepoDigests), func(ref string) bool { return !hasPrefix(ref) }),
+		}
+		if len(pulled.tags) > 0 || len(pulled.digests) > 0 {
+			result = append(result, pulled)
+		}
+	}
 	return result, nil
 }
 
+// resolveImages maps the upstream images to the mirrored and pinned ones.
+func resolveImages(cfg config.Configuration, images []string) []string {
+	res := make([]string, 0, len(images))
+	for _, img := range images {
+		res = append(res, cfg.Images.Resolve(img))
+	}
+	return res
+}
+
 func parseAllModelsRunnerImageTag(staticStore *store.StaticStore) ([]string, error) {
 	composePath := staticStore.GetComposeFolder()
 	brickNamespace := "arduino"
@@ -229,13 +269,13 @@ func (s SystemCleanupResult) IsEmpty() bool {
 func SystemCleanup(ctx context.Context, cfg config.Configuration, staticStore *store.StaticStore, docker command.Cli) (SystemCleanupResult, error) {
 	var result SystemCleanupResult
 
-	// Remove running app and dangling containers
-	runningApp, err := getRunningApp(ctx, docker.Client())
+	// Remove running apps and dangling containers
+	runningApps, err := getRunningApps(ctx, docker.Client(), cfg)
 	if err != nil {
-		feedback.Warnf("failed to get running app - %v", err)
+		feedback.Warnf("failed to get running apps - %v", err)
 	}
-	if runningApp != nil {
-		for item := range StopAndDestroyApp(ctx, *runningApp) {
+	for _, runningApp := range runningApps {
+		for item := range StopAndDestroyApp(ctx, runningApp, cfg) {
 			if item.GetType() == ErrorType {
 				feedback.Warnf("failed to stop and destroy running app - %v", item.GetError())
 				break
@@ -254,13 +294,26 @@ func SystemCleanup(ctx context.Context, cfg config.Configuration, staticStore *s
 	if err != nil {
 		return result, err
 	}
-	allImages, err := listImagesAlreadyPulled(ctx, docker.Client())
+	allImages, err := listPulledImages(ctx, docker.Client(), cfg.Images.MirrorPrefixes()...)
 	if err != nil {
 		return result, err
 	}
-	imagesToRemove := slices.DeleteFunc(allImages, func(v string) bool {
-		return slices.Contains(containersMustStay, v)
-	})
+	isRequired := func(ref string) bool {
+		return slices.ContainsFunc(containersMustStay, func(required string) bool { return config.SameImage(required, ref) })
+	}
+	var imagesToRemove []string
+	for _, image := range allImages {
+		// An image is kept if it is required by any of its references.
+		if slices.ContainsFunc(image.refs(), isRequired) {
+			continue
+		}
+		// Docker removes the digests of an image together with its last tag.
+		if len(image.tags) > 0 {
+			imagesToRemove = append(imagesToRemove, image.tags...)
+		} else {
+			imagesToRemove = append(imagesToRemove, image.digests...)
+		}
+	}
 
 	for _, image := range imagesToRemove {
 		imageSize, err := removeImage(ctx, docker.Client(), image)
@@ -302,8 +355,23 @@ func getRequiredImages(cfg config.Configuration, staticStore *store.StaticStore)
 		return nil, fmt.Errorf("failed to parse models runner images: %w", err)
 	}
 
-	requiredImages = append(requiredImages, modelsRunnersContainers...)
-	return requiredImages, nil
+	requiredImages = append(requiredImages, resolveImages(cfg, modelsRunnersContainers)...)
+
+	// The images of the pinned and of the default assets are kept too, so that it is
+	// possible to switch between them without pulling the images again.
+	for _, version := range assets.KeptVersions(cfg) {
+		dir := cfg.AssetsDir().Join(version)
+		if version == cfg.UsedPythonImageTag || dir.NotExist() {
+			continue
+		}
+		images, err := parseAllModelsRunnerImageTag(store.NewStaticStore(dir.String()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse models runner images of the assets %s: %w", version, err)
+		}
+		requiredImages = append(requiredImages, cfg.Images.Resolve(assets.RunnerImage(cfg, version)))
+		requiredImages = append(requiredImages, resolveImages(cfg, images)...)
+	}
+	return f.Uniq(requiredImages), nil
 }
 
 func removeDanglingContainers(ctx context.Context, docker dockerClient.APIClient) (int, error) {
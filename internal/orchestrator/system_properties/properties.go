@@ -16,19 +16,17 @@
 package properties
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"maps"
 	"os"
-	"regexp"
 	"slices"
-	"time"
 
-	"github.com/gofrs/flock"
 	"github.com/google/renameio/v2"
 	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/arduino/arduino-app-cli/internal/filestore"
 )
 
 var (
@@ -36,7 +34,7 @@ var (
 )
 
 func ReadPropertyKeys(filePath string) ([]string, error) {
-	unlock, err := getReadLock(filePath)
+	unlock, err := filestore.ReadLock(filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -57,11 +55,11 @@ func ReadPropertyKeys(filePath string) ([]string, error) {
 }
 
 func UpsertProperty(filePath string, key string, value []byte) error {
-	if err := validateKey(key); err != nil {
+	if err := filestore.ValidateKey(key); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidKey, err)
 	}
 
-	unlock, err := getWriteLock(filePath)
+	unlock, err := filestore.WriteLock(filePath)
 	if err != nil {
 		return err
 	}
@@ -84,11 +82,11 @@ func UpsertProperty(filePath string, key string, value []byte) error {
 }
 
 func DeleteProperty(filePath string, key string) (bool, error) {
-	if err := validateKey(key); err != nil {
+	if err := filestore.ValidateKey(key); err != nil {
 		return false, fmt.Errorf("%w: %w", ErrInvalidKey, err)
 	}
 
-	unlock, err := getWriteLock(filePath)
+	unlock, err := filestore.WriteLock(filePath)
 	if err != nil {
 		return false, err
 	}
@@ -117,11 +115,11 @@ func DeleteProperty(filePath string, key string) (bool, error) {
 }
 
 func GetProperty(filePath string, key string) ([]byte, bool, error) {
-	if err := validateKey(key); err != nil {
+	if err := filestore.ValidateKey(key); err != nil {
 		return nil, false, fmt.Errorf("%w: %w", ErrInvalidKey, err)
 	}
 
-	unlock, err := getReadLock(filePath)
+	unlock, err := filestore.ReadLock(filePath)
 	if err != nil {
 		return nil, false, err
 	}
@@ -157,74 +155,3 @@ func readPropertyMap(filePath string) (map[string][]byte, error) {
 
 	return propertiesMap, nil
 }
-
-const maxKeyLength = 100
-
-var keyValidationRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
-
-func validateKey(key string) error {
-	if key == "" {
-		return fmt.Errorf("key cannot be empty")
-	}
-	if !keyValidationRegex.MatchString(key) {
-		return fmt.Errorf("key '%s' contains invalid characters; only alphanumeric, '-', '_', and '.' are allowed", key)
-	}
-	if len(key) > maxKeyLength {
-		return fmt.Errorf("key exceeds max length of %d characters", maxKeyLength)
-	}
-
-	return nil
-}
-
-type lockFunc func(context.Context, time.Duration) (bool, error)
-
-type UnlockFunc func() error
-
-func emptyUnlockFunc() error {
-	return nil
-}
-
-func getLock(flock *flock.Flock, lockFn lockFunc, errorMsg string) (UnlockFunc, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	locked, err := lockFn(ctx, 100*time.Millisecond)
-	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			if err := flock.Unlock(); err != nil {
-				slog.Error("failed to unlock file lock", "path", flock.Path(), "error", err)
-			}
-			if err := os.Remove(flock.Path()); err != nil {
-				slog.Error("failed to delete lock file", "path", flock.Path(), "error", err)
-			}
-			locked = false
-			slog.Warn("lock file removed due to timeout", "path", flock.Path())
-		} else {
-			return emptyUnlockFunc, fmt.Errorf("failed trying to acquire %s for %s: %w", errorMsg, flock.Path(), err)
-		}
-	}
-	if !locked {
-		return emptyUnlockFunc, fmt.Errorf("unable to acquire %s for %s", errorMsg, flock.Path())
-	}
-
-	return func() error {
-		if err := flock.Unlock(); err != nil {
-			return fmt.Errorf("failed to unlock file lock for %s: %w", flock.Path(), err)
-		}
-		return nil
-	}, nil
-}
-
-func getWriteLock(filePath string) (UnlockFunc, error) {
-	fileLock := flock.New(getLockFilePath(filePath))
-	return getLock(fileLock, fileLock.TryLockContext, "write lock")
-}
-
-func getReadLock(filePath string) (UnlockFunc, error) {
-	fileLock := flock.New(getLockFilePath(filePath))
-	return getLock(fileLock, fileLock.TryRLockContext, "read lock")
-}
-
-func getLockFilePath(path string) string {
-	return path + ".lock"
-}
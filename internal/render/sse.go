@@ -30,11 +30,16 @@ type SSEErrCode string
 
 const (
 	InternalServiceErr SSEErrCode = "INTERNAL_SERVER_ERROR"
+	// FlashOwnedErr tells that the operation would overwrite the sketch of another app
+	// stored in the flash of the micro.
+	FlashOwnedErr SSEErrCode = "FLASH_OWNED"
 )
 
 type SSEErrorData struct {
 	Code    SSEErrCode `json:"code"`
 	Message string     `json:"message,omitempty"`
+	// Owner is the name of the app owning the flash of the micro, set with FlashOwnedErr.
+	Owner string `json:"owner,omitempty"`
 }
 
 type SSEEvent struct {
@@ -32,6 +32,7 @@ type StaticStore struct {
 	assetsPath       *paths.Path
 	apiDocsPath      string
 	codeExamplesPath string
+	customBricksPath *paths.Path
 }
 
 func NewStaticStore(baseDir string) *StaticStore {
@@ -45,6 +46,14 @@ func NewStaticStore(baseDir string) *StaticStore {
 	}
 }
 
+// NewStaticStoreWithCustomBricks creates a store that also resolves the files of the
+// custom bricks stored in the <namespace>/<name> folders of customBricksDir.
+func NewStaticStoreWithCustomBricks(baseDir string, customBricksDir *paths.Path) *StaticStore {
+	s := NewStaticStore(baseDir)
+	s.customBricksPath = customBricksDir
+	return s
+}
+
 func (s *StaticStore) SaveComposeFolderTo(dst string) error {
 	composeFS := s.GetComposeFolder()
 	dstPath := paths.New(dst)
@@ -68,6 +77,14 @@ func (s *StaticStore) GetBrickReadmeFromID(brickID string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if dir := s.customBrickDir(namespace, brickName); dir != nil {
+		// The README of a custom brick is optional.
+		content, err := dir.Join("README.md").ReadFile()
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		return string(content), nil
+	}
 	content, err := os.ReadFile(filepath.Join(s.docsPath, namespace, brickName, "README.md"))
 	if err != nil {
 		return "", err
@@ -80,6 +97,9 @@ func (s *StaticStore) GetBrickComposeFilePathFromID(brickID string) (*paths.Path
 	if err != nil {
 		return nil, err
 	}
+	if dir := s.customBrickDir(namespace, brickName); dir != nil {
+		return dir.Join("brick_compose.yaml"), nil
+	}
 	return paths.New(s.composePath, namespace, brickName, "brick_compose.yaml"), nil
 }
 
@@ -88,6 +108,9 @@ func (s *StaticStore) GetBrickApiDocPathFromID(brickID string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if dir := s.customBrickDir(namespace, brickName); dir != nil {
+		return dir.Join("API.md").String(), nil
+	}
 	return filepath.Join(s.apiDocsPath, namespace, "app_bricks", brickName, "API.md"), nil
 }
 
@@ -97,6 +120,9 @@ func (s *StaticStore) GetBrickCodeExamplesPathFromID(brickID string) (paths.Path
 		return nil, err
 	}
 	targetDir := paths.New(s.codeExamplesPath, namespace, brickName)
+	if dir := s.customBrickDir(namespace, brickName); dir != nil {
+		targetDir = dir.Join("examples")
+	}
 	dirEntries, err := targetDir.ReadDir()
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -107,6 +133,19 @@ func (s *StaticStore) GetBrickCodeExamplesPathFromID(brickID string) (paths.Path
 	return dirEntries, nil
 }
 
+// customBrickDir returns the folder of the custom brick, or nil if the brick is not a custom one.
+func (s *StaticStore) customBrickDir(namespace, brickName string) *paths.Path {
+	// The arduino namespace is reserved to the bricks shipped with the assets.
+	if s.customBricksPath == nil || namespace == "arduino" {
+		return nil
+	}
+	dir := s.customBricksPath.Join(namespace, brickName)
+	if dir.Join("brick.yaml").NotExist() {
+		return nil
+	}
+	return dir
+}
+
 func parseBrickID(brickID string) (namespace, name string, err error) {
 	namespace, brickName, ok := strings.Cut(brickID, ":")
 	if !ok {
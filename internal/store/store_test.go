@@ -144,3 +144,40 @@ func TestGetBrickCodeExamplesPathFromID(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomBricks(t *testing.T) {
+	customDir := paths.New(t.TempDir())
+	brickDir := customDir.Join("myteam", "sensor_hub")
+	require.NoError(t, brickDir.Join("examples", "basic").MkdirAll())
+	require.NoError(t, brickDir.Join("brick.yaml").WriteFile([]byte("name: Sensor Hub\n")))
+	require.NoError(t, customDir.Join("arduino", "arduino_cloud").MkdirAll())
+	require.NoError(t, customDir.Join("arduino", "arduino_cloud", "brick.yaml").WriteFile([]byte("name: Fake\n")))
+
+	store := NewStaticStoreWithCustomBricks(paths.New("testdata", "assets", "0.4.8").String(), customDir)
+
+	composePath, err := store.GetBrickComposeFilePathFromID("myteam:sensor_hub")
+	require.NoError(t, err)
+	require.Equal(t, brickDir.Join("brick_compose.yaml").String(), composePath.String())
+
+	apiDocPath, err := store.GetBrickApiDocPathFromID("myteam:sensor_hub")
+	require.NoError(t, err)
+	require.Equal(t, brickDir.Join("API.md").String(), apiDocPath)
+
+	examples, err := store.GetBrickCodeExamplesPathFromID("myteam:sensor_hub")
+	require.NoError(t, err)
+	require.Len(t, examples, 1)
+
+	// The README of a custom brick is optional.
+	readme, err := store.GetBrickReadmeFromID("myteam:sensor_hub")
+	require.NoError(t, err)
+	require.Empty(t, readme)
+	require.NoError(t, brickDir.Join("README.md").WriteFile([]byte("# Sensor Hub")))
+	readme, err = store.GetBrickReadmeFromID("myteam:sensor_hub")
+	require.NoError(t, err)
+	require.Equal(t, "# Sensor Hub", readme)
+
+	// The bricks shipped with the assets cannot be overridden.
+	composePath, err = store.GetBrickComposeFilePathFromID(validBrickID)
+	require.NoError(t, err)
+	require.Equal(t, "testdata/assets/0.4.8/compose/arduino/arduino_cloud/brick_compose.yaml", composePath.String())
+}
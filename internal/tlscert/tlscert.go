@@ -0,0 +1,141 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package tlscert provides the certificate used by the daemon to listen on the network.
+package tlscert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+const validity = 10 * 365 * 24 * time.Hour
+
+// ForRemote returns the certificate of the remote listener: the one configured by the user
+// or the self-signed one, generated on the first call.
+func ForRemote(cfg config.Configuration) (tls.Certificate, error) {
+	if cfg.Daemon.Remote.CertFile != "" {
+		return Load(paths.New(cfg.Daemon.Remote.CertFile), paths.New(cfg.Daemon.Remote.KeyFile))
+	}
+	hosts := []string{"localhost"}
+	if hostname, err := os.Hostname(); err == nil {
+		hosts = []string{hostname, hostname + ".local", "localhost"}
+	}
+	return LoadOrCreate(cfg.TLSDir().Join("cert.pem"), cfg.TLSDir().Join("key.pem"), hosts)
+}
+
+// LoadOrCreate loads the certificate and the key from the given files. If the files do not
+// exist, a self-signed certificate for the given hosts is generated and saved.
+func LoadOrCreate(certFile, keyFile *paths.Path, hosts []string) (tls.Certificate, error) {
+	if certFile.Exist() || keyFile.Exist() {
+		return tls.LoadX509KeyPair(certFile.String(), keyFile.String())
+	}
+
+	certPEM, keyPEM, err := generate(hosts)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := certFile.Parent().MkdirAll(); err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := fatomic.WriteFile(keyFile.String(), keyPEM, 0600); err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := fatomic.WriteFile(certFile.String(), certPEM, 0644); err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// Load loads a certificate and its key, provided by the user.
+func Load(certFile, keyFile *paths.Path) (tls.Certificate, error) {
+	if certFile.NotExist() {
+		return tls.Certificate{}, fmt.Errorf("certificate %s: %w", certFile, os.ErrNotExist)
+	}
+	return tls.LoadX509KeyPair(certFile.String(), keyFile.String())
+}
+
+func generate(hosts []string) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Arduino App CLI"}, CommonName: firstOr(hosts, "arduino-app-cli")},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              hosts,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the certificate, in the colon separated
+// form shown by the browsers, that the clients use to pin the board.
+func Fingerprint(cert tls.Certificate) (string, error) {
+	if len(cert.Certificate) == 0 {
+		return "", errors.New("empty certificate")
+	}
+	sum := sha256.Sum256(cert.Certificate[0])
+	hexSum := strings.ToUpper(hex.EncodeToString(sum[:]))
+	parts := make([]string, 0, len(sum))
+	for i := 0; i < len(hexSum); i += 2 {
+		parts = append(parts, hexSum[i:i+2])
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+func firstOr(values []string, def string) string {
+	if len(values) > 0 {
+		return values[0]
+	}
+	return def
+}
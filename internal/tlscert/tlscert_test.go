@@ -0,0 +1,61 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package tlscert
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOrCreate(t *testing.T) {
+	dir := paths.New(t.TempDir()).Join("tls")
+	certFile, keyFile := dir.Join("cert.pem"), dir.Join("key.pem")
+
+	cert, err := LoadOrCreate(certFile, keyFile, []string{"board", "board.local"})
+	require.NoError(t, err)
+	require.FileExists(t, certFile.String())
+	info, err := keyFile.Stat()
+	require.NoError(t, err)
+	require.Equal(t, "-rw-------", info.Mode().Perm().String())
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	require.Equal(t, []string{"board", "board.local"}, leaf.DNSNames)
+	require.Equal(t, "board", leaf.Subject.CommonName)
+
+	fingerprint, err := Fingerprint(cert)
+	require.NoError(t, err)
+	require.Regexp(t, `^([0-9A-F]{2}:){31}[0-9A-F]{2}$`, fingerprint)
+
+	// The certificate is generated only once, so that the clients can pin it.
+	again, err := LoadOrCreate(certFile, keyFile, []string{"other"})
+	require.NoError(t, err)
+	againFingerprint, err := Fingerprint(again)
+	require.NoError(t, err)
+	require.Equal(t, fingerprint, againFingerprint)
+
+	t.Run("provided by the user", func(t *testing.T) {
+		loaded, err := Load(certFile, keyFile)
+		require.NoError(t, err)
+		require.Equal(t, cert.Certificate, loaded.Certificate)
+
+		_, err = Load(dir.Join("missing.pem"), keyFile)
+		require.ErrorContains(t, err, "missing.pem")
+	})
+}